@@ -0,0 +1,313 @@
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"memo/db/model"
+)
+
+// Format is the container format of a memo archive.
+type Format string
+
+const (
+	FormatTarGz Format = "tar.gz"
+	FormatZip   Format = "zip"
+)
+
+const (
+	manifestName    = "manifest.json"
+	memoDir         = "memos"
+	manifestVersion = 1
+	// maxExtractedBytes bounds the total size of the files extracted from an
+	// archive, so a small compressed archive cannot expand without limit.
+	maxExtractedBytes = 512 << 20
+)
+
+// ErrTooLarge is returned by Read when the extracted files exceed maxExtractedBytes.
+var ErrTooLarge = errors.New("archive is too large")
+
+// Manifest describes the memos contained in an archive.
+type Manifest struct {
+	Version    int             `json:"version"`
+	ExportedAt time.Time       `json:"exported_at"`
+	Memos      []ManifestEntry `json:"memos"`
+}
+
+// ManifestEntry is the metadata of a single archived memo.
+type ManifestEntry struct {
+	ID        string         `json:"id"`
+	Title     string         `json:"title"`
+	FileType  model.FileType `json:"file_type"`
+	Notebook  string         `json:"notebook,omitempty"`
+	Path      string         `json:"path"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// Validate checks that the ID, title and file type are safe to use in a memo
+// file name, so an imported memo cannot be written outside its folder.
+func (e ManifestEntry) Validate() error {
+	if e.ID == "" || strings.ContainsAny(e.ID, `_/\`) || strings.Contains(e.ID, "..") {
+		return fmt.Errorf("invalid memo id %q", e.ID)
+	}
+	if e.Title == "" || strings.ContainsAny(e.Title, `_/\`) || strings.HasPrefix(e.Title, ".") {
+		return fmt.Errorf("invalid memo title %q", e.Title)
+	}
+	if !e.FileType.IsValid() {
+		return fmt.Errorf("invalid memo file type %q", e.FileType)
+	}
+	return nil
+}
+
+// Entry is a memo read back from an archive.
+type Entry struct {
+	ManifestEntry
+	Content string
+}
+
+// Write writes the given memos and their manifest to w in the given format.
+func Write(w io.Writer, format Format, memos []*model.Memo) error {
+	manifest := Manifest{
+		Version:    manifestVersion,
+		ExportedAt: time.Now(),
+		Memos:      make([]ManifestEntry, 0, len(memos)),
+	}
+	for _, memo := range memos {
+		manifest.Memos = append(manifest.Memos, ManifestEntry{
+			ID:        memo.ID,
+			Title:     memo.Title,
+			FileType:  memo.FileType,
+			Notebook:  memo.Notebook,
+			Path:      path.Join(memoDir, memo.Notebook, filepath.Base(memo.GetFilePath(""))),
+			CreatedAt: memo.CreatedAt,
+			UpdatedAt: memo.UpdatedAt,
+		})
+	}
+
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+
+	switch format {
+	case FormatTarGz:
+		return writeTarGz(w, manifestData, manifest.Memos, memos)
+	case FormatZip:
+		return writeZip(w, manifestData, manifest.Memos, memos)
+	default:
+		return fmt.Errorf("unsupported archive format: %q", format)
+	}
+}
+
+func writeTarGz(w io.Writer, manifestData []byte, entries []ManifestEntry, memos []*model.Memo) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	writeFile := func(name string, data []byte, modTime time.Time) error {
+		header := &tar.Header{
+			Name:    name,
+			Mode:    0644,
+			Size:    int64(len(data)),
+			ModTime: modTime,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return fmt.Errorf("failed to write tar header for %s: %w", name, err)
+		}
+		if _, err := tw.Write(data); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+		return nil
+	}
+
+	if err := writeFile(manifestName, manifestData, time.Now()); err != nil {
+		return err
+	}
+	for i, memo := range memos {
+		if err := writeFile(entries[i].Path, []byte(memo.Content), memo.UpdatedAt); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to close tar writer: %w", err)
+	}
+	if err := gw.Close(); err != nil {
+		return fmt.Errorf("failed to close gzip writer: %w", err)
+	}
+	return nil
+}
+
+func writeZip(w io.Writer, manifestData []byte, entries []ManifestEntry, memos []*model.Memo) error {
+	zw := zip.NewWriter(w)
+
+	writeFile := func(name string, data []byte, modTime time.Time) error {
+		fw, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     name,
+			Method:   zip.Deflate,
+			Modified: modTime,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create zip entry %s: %w", name, err)
+		}
+		if _, err := fw.Write(data); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+		return nil
+	}
+
+	if err := writeFile(manifestName, manifestData, time.Now()); err != nil {
+		return err
+	}
+	for i, memo := range memos {
+		if err := writeFile(entries[i].Path, []byte(memo.Content), memo.UpdatedAt); err != nil {
+			return err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("failed to close zip writer: %w", err)
+	}
+	return nil
+}
+
+// DetectFormat guesses the archive format from its leading bytes.
+func DetectFormat(data []byte) (Format, error) {
+	switch {
+	case bytes.HasPrefix(data, []byte{0x1f, 0x8b}):
+		return FormatTarGz, nil
+	case bytes.HasPrefix(data, []byte("PK\x03\x04")):
+		return FormatZip, nil
+	default:
+		return "", fmt.Errorf("unrecognized archive format")
+	}
+}
+
+// Read parses an archive and returns its memos in manifest order.
+func Read(data []byte, format Format) ([]Entry, error) {
+	var files map[string][]byte
+	var err error
+
+	switch format {
+	case FormatTarGz:
+		files, err = readTarGz(data)
+	case FormatZip:
+		files, err = readZip(data)
+	default:
+		return nil, fmt.Errorf("unsupported archive format: %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	manifestData, ok := files[manifestName]
+	if !ok {
+		return nil, fmt.Errorf("archive has no %s", manifestName)
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %w", err)
+	}
+	if manifest.Version != manifestVersion {
+		return nil, fmt.Errorf("unsupported manifest version: %d", manifest.Version)
+	}
+
+	entries := make([]Entry, 0, len(manifest.Memos))
+	for _, memo := range manifest.Memos {
+		content, ok := files[memo.Path]
+		if !ok {
+			return nil, fmt.Errorf("archive is missing %s", memo.Path)
+		}
+		entries = append(entries, Entry{
+			ManifestEntry: memo,
+			Content:       string(content),
+		})
+	}
+
+	return entries, nil
+}
+
+func readTarGz(data []byte) (map[string][]byte, error) {
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open gzip stream: %w", err)
+	}
+	defer gr.Close()
+
+	files := make(map[string][]byte)
+	remaining := int64(maxExtractedBytes)
+	tr := tar.NewReader(gr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tar entry: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		content, err := readLimited(tr, &remaining)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", header.Name, err)
+		}
+		files[header.Name] = content
+	}
+
+	return files, nil
+}
+
+func readZip(data []byte) (map[string][]byte, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open zip archive: %w", err)
+	}
+
+	files := make(map[string][]byte)
+	remaining := int64(maxExtractedBytes)
+	for _, file := range zr.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+
+		rc, err := file.Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s: %w", file.Name, err)
+		}
+		content, err := readLimited(rc, &remaining)
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", file.Name, err)
+		}
+		files[file.Name] = content
+	}
+
+	return files, nil
+}
+
+// readLimited reads r to the end and subtracts its size from remaining. It
+// returns ErrTooLarge instead of reading past remaining.
+func readLimited(r io.Reader, remaining *int64) ([]byte, error) {
+	content, err := io.ReadAll(io.LimitReader(r, *remaining+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) > *remaining {
+		return nil, ErrTooLarge
+	}
+	*remaining -= int64(len(content))
+	return content, nil
+}
@@ -0,0 +1,82 @@
+package archive
+
+import (
+	"bytes"
+	"testing"
+
+	"memo/db/model"
+)
+
+func TestWriteRead(t *testing.T) {
+	memos := []*model.Memo{
+		{ID: "id-1", Title: "First", FileType: model.FileTypeMd, Content: "# First"},
+		{ID: "id-2", Title: "Second", FileType: model.FileTypeTxt, Content: "second memo"},
+	}
+
+	for _, format := range []Format{FormatTarGz, FormatZip} {
+		var buf bytes.Buffer
+		if err := Write(&buf, format, memos); err != nil {
+			t.Fatalf("Write(%s) failed: %v", format, err)
+		}
+
+		detected, err := DetectFormat(buf.Bytes())
+		if err != nil {
+			t.Fatalf("DetectFormat(%s) failed: %v", format, err)
+		}
+		if detected != format {
+			t.Errorf("Expected format %s, got %s", format, detected)
+		}
+
+		entries, err := Read(buf.Bytes(), detected)
+		if err != nil {
+			t.Fatalf("Read(%s) failed: %v", format, err)
+		}
+		if len(entries) != len(memos) {
+			t.Fatalf("Expected %d entries, got %d", len(memos), len(entries))
+		}
+
+		for i, entry := range entries {
+			if entry.ID != memos[i].ID || entry.Title != memos[i].Title || entry.FileType != memos[i].FileType {
+				t.Errorf("Entry %d metadata mismatch: %+v", i, entry.ManifestEntry)
+			}
+			if entry.Content != memos[i].Content {
+				t.Errorf("Entry %d: expected content %q, got %q", i, memos[i].Content, entry.Content)
+			}
+		}
+	}
+}
+
+func TestDetectFormatUnknown(t *testing.T) {
+	if _, err := DetectFormat([]byte("plain text")); err == nil {
+		t.Error("DetectFormat should fail for unknown data")
+	}
+}
+
+func TestManifestEntryValidate(t *testing.T) {
+	valid := ManifestEntry{ID: "id-1", Title: "First", FileType: model.FileTypeMd}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Expected valid entry, got %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		entry ManifestEntry
+	}{
+		{"title traversal", ManifestEntry{ID: "x", Title: "../escaped", FileType: model.FileTypeMd}},
+		{"title separator", ManifestEntry{ID: "x", Title: `a\b`, FileType: model.FileTypeMd}},
+		{"hidden title", ManifestEntry{ID: "x", Title: ".git", FileType: model.FileTypeMd}},
+		{"id traversal", ManifestEntry{ID: "..", Title: "t", FileType: model.FileTypeMd}},
+		{"id separator", ManifestEntry{ID: "a/b", Title: "t", FileType: model.FileTypeMd}},
+		{"id underscore", ManifestEntry{ID: "a_b", Title: "t", FileType: model.FileTypeMd}},
+		{"empty id", ManifestEntry{Title: "t", FileType: model.FileTypeMd}},
+		{"file type traversal", ManifestEntry{ID: "x", Title: "t", FileType: "md/../../x"}},
+		{"empty file type", ManifestEntry{ID: "x", Title: "t"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.entry.Validate(); err == nil {
+				t.Errorf("Expected %+v to be rejected", tt.entry)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	config "memo/config/server"
+	authpb "memo/grpc/auth"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// User is the authenticated caller of a request.
+type User struct {
+	ID    string
+	Email string
+	Name  string
+}
+
+// Verifier validates bearer tokens issued by the auth service.
+type Verifier interface {
+	Verify(ctx context.Context, token string) (*User, error)
+	Close() error
+}
+
+// NewVerifier creates a Verifier for the configured mode.
+// It returns nil when authentication is disabled.
+func NewVerifier(cfg config.Auth) (Verifier, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	switch cfg.Mode {
+	case "", "remote":
+		if cfg.Address == "" {
+			return nil, fmt.Errorf("auth address is required in remote mode")
+		}
+		conn, err := grpc.NewClient(cfg.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to auth service: %w", err)
+		}
+		return &remoteVerifier{conn: conn, client: authpb.NewAuthServiceClient(conn)}, nil
+	case "local":
+		if cfg.JWTSecret == "" {
+			return nil, fmt.Errorf("jwt_secret is required in local mode")
+		}
+		return &localVerifier{secretKey: []byte(cfg.JWTSecret)}, nil
+	default:
+		return nil, fmt.Errorf("unknown auth mode: %q", cfg.Mode)
+	}
+}
+
+// remoteVerifier asks the auth service to verify each token.
+type remoteVerifier struct {
+	conn   *grpc.ClientConn
+	client authpb.AuthServiceClient
+}
+
+func (v *remoteVerifier) Verify(ctx context.Context, token string) (*User, error) {
+	res, err := v.client.VerifyToken(ctx, &authpb.VerifyTokenRequest{Token: token})
+	if err != nil {
+		return nil, err
+	}
+	return &User{ID: res.UserId, Email: res.Email, Name: res.Name}, nil
+}
+
+func (v *remoteVerifier) Close() error {
+	return v.conn.Close()
+}
+
+// userClaims mirrors the claims issued by the auth service.
+type userClaims struct {
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+	Name   string `json:"name"`
+	jwt.RegisteredClaims
+}
+
+// localVerifier verifies tokens with the secret shared with the auth service.
+type localVerifier struct {
+	secretKey []byte
+}
+
+func (v *localVerifier) Verify(ctx context.Context, tokenString string) (*User, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &userClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected token signing method")
+		}
+		return v.secretKey, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+
+	claims, ok := token.Claims.(*userClaims)
+	if !ok || claims.UserID == "" {
+		return nil, fmt.Errorf("invalid token claims")
+	}
+
+	return &User{ID: claims.UserID, Email: claims.Email, Name: claims.Name}, nil
+}
+
+func (v *localVerifier) Close() error {
+	return nil
+}
+
+type userKey struct{}
+
+// NewContext returns a context carrying the authenticated user.
+func NewContext(ctx context.Context, user *User) context.Context {
+	return context.WithValue(ctx, userKey{}, user)
+}
+
+// UserFromContext returns the authenticated user, if any.
+func UserFromContext(ctx context.Context) (*User, bool) {
+	user, ok := ctx.Value(userKey{}).(*User)
+	return user, ok
+}
+
+// adminServicePrefix is the method prefix of MemoAdminService.
+const adminServicePrefix = "/memo.MemoAdminService/"
+
+// UnaryServerInterceptor rejects unary calls without a valid bearer token.
+// Admin methods additionally require one of the admin user IDs.
+func UnaryServerInterceptor(v Verifier, adminUserIDs []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if skipAuth(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		ctx, err := authenticate(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		if err := authorize(ctx, info.FullMethod, adminUserIDs); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rejects streaming calls without a valid bearer token.
+// Admin methods additionally require one of the admin user IDs.
+func StreamServerInterceptor(v Verifier, adminUserIDs []string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if skipAuth(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		ctx, err := authenticate(ss.Context(), v)
+		if err != nil {
+			return err
+		}
+		if err := authorize(ctx, info.FullMethod, adminUserIDs); err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authorize restricts admin methods to the configured admin users.
+func authorize(ctx context.Context, fullMethod string, adminUserIDs []string) error {
+	if !strings.HasPrefix(fullMethod, adminServicePrefix) {
+		return nil
+	}
+
+	user, ok := UserFromContext(ctx)
+	if ok && slices.Contains(adminUserIDs, user.ID) {
+		return nil
+	}
+	return status.Error(codes.PermissionDenied, "admin privileges are required")
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// skipAuth reports whether a method is served without authentication.
+func skipAuth(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/grpc.reflection.")
+}
+
+func authenticate(ctx context.Context, v Verifier) (context.Context, error) {
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := v.Verify(ctx, token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", status.Convert(err).Message())
+	}
+
+	return NewContext(ctx, user), nil
+}
+
+func bearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", status.Error(codes.Unauthenticated, "missing authorization header")
+	}
+
+	token, ok := strings.CutPrefix(values[0], "Bearer ")
+	if !ok || token == "" {
+		return "", status.Error(codes.Unauthenticated, "authorization header must be a bearer token")
+	}
+
+	return token, nil
+}
@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	config "memo/config/server"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func signToken(t *testing.T, secret string, claims userClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return token
+}
+
+func TestLocalVerifier(t *testing.T) {
+	verifier, err := NewVerifier(config.Auth{Enabled: true, Mode: "local", JWTSecret: "test-secret-key"})
+	if err != nil {
+		t.Fatalf("NewVerifier failed: %v", err)
+	}
+
+	token := signToken(t, "test-secret-key", userClaims{
+		UserID: "test-user-id",
+		Email:  "test@example.com",
+		Name:   "Test User",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	})
+
+	user, err := verifier.Verify(context.Background(), token)
+	if err != nil {
+		t.Fatalf("Verify failed: %v", err)
+	}
+	if user.ID != "test-user-id" {
+		t.Errorf("Expected UserID test-user-id, got %s", user.ID)
+	}
+
+	other := signToken(t, "other-secret", userClaims{UserID: "test-user-id"})
+	if _, err := verifier.Verify(context.Background(), other); err == nil {
+		t.Error("Verify should fail for a token signed with another secret")
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	verifier, _ := NewVerifier(config.Auth{Enabled: true, Mode: "local", JWTSecret: "test-secret-key"})
+	token := signToken(t, "test-secret-key", userClaims{UserID: "test-user-id"})
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	ctx, err := authenticate(ctx, verifier)
+	if err != nil {
+		t.Fatalf("authenticate failed: %v", err)
+	}
+	if user, ok := UserFromContext(ctx); !ok || user.ID != "test-user-id" {
+		t.Errorf("Expected user test-user-id in context, got %v", user)
+	}
+
+	for _, md := range []metadata.MD{
+		metadata.Pairs(),
+		metadata.Pairs("authorization", token),
+		metadata.Pairs("authorization", "Bearer invalid"),
+	} {
+		_, err := authenticate(metadata.NewIncomingContext(context.Background(), md), verifier)
+		if status.Code(err) != codes.Unauthenticated {
+			t.Errorf("Expected Unauthenticated for %v, got %v", md, err)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	pb "memo/grpc"
+)
+
+// importChunkSize is the payload of each ArchiveChunk sent by import.
+const importChunkSize = 64 * 1024
+
+var archiveFormats = map[string]pb.ArchiveFormat{
+	"tar.gz": pb.ArchiveFormat_ARCHIVE_FORMAT_TAR_GZ,
+	"zip":    pb.ArchiveFormat_ARCHIVE_FORMAT_ZIP,
+}
+
+var conflictPolicies = map[string]pb.ConflictPolicy{
+	"skip":      pb.ConflictPolicy_CONFLICT_POLICY_SKIP,
+	"overwrite": pb.ConflictPolicy_CONFLICT_POLICY_OVERWRITE,
+	"rename":    pb.ConflictPolicy_CONFLICT_POLICY_RENAME,
+}
+
+func runExport(c *client, args []string) error {
+	fs := newFlagSet("export", "[id]...", "Export memos to an archive. Without IDs, every memo is exported.")
+	format := fs.String("format", "tar.gz", "archive format: tar.gz or zip")
+	out := fs.String("out", "", "file to write the archive to (default stdout)")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	archiveFormat, ok := archiveFormats[*format]
+	if !ok {
+		fs.Usage()
+		return errUsage
+	}
+	if *out == "" && stdoutIsTerminal() {
+		return errors.New("refusing to write an archive to a terminal: use --out or redirect stdout")
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	stream, err := c.memo.ExportMemos(ctx, &pb.ExportRequest{MemoIds: args, Format: archiveFormat})
+	if err != nil {
+		return err
+	}
+
+	w := os.Stdout
+	if *out != "" {
+		if w, err = os.Create(*out); err != nil {
+			return fmt.Errorf("failed to create archive: %w", err)
+		}
+		defer w.Close()
+	}
+
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(chunk.GetData()); err != nil {
+			return fmt.Errorf("failed to write archive: %w", err)
+		}
+	}
+
+	if w != os.Stdout {
+		if err := w.Close(); err != nil {
+			return fmt.Errorf("failed to write archive: %w", err)
+		}
+	}
+	return nil
+}
+
+func runImport(c *client, args []string) error {
+	fs := newFlagSet("import", "<file>", "Import memos from an archive. Use - to read the archive from stdin.")
+	format := fs.String("format", "", "archive format: tar.gz or zip (default detected from the content)")
+	conflict := fs.String("conflict", "skip", "what to do with memos that already exist: skip, overwrite or rename")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	archiveFormat, formatOk := archiveFormats[*format]
+	policy, policyOk := conflictPolicies[*conflict]
+	if len(args) != 1 || (*format != "" && !formatOk) || !policyOk {
+		fs.Usage()
+		return errUsage
+	}
+
+	r := io.Reader(os.Stdin)
+	if args[0] != "-" {
+		file, err := os.Open(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to open archive: %w", err)
+		}
+		defer file.Close()
+		r = file
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	stream, err := c.memo.ImportMemos(ctx)
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, importChunkSize)
+	first := true
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			chunk := &pb.ArchiveChunk{Data: append([]byte(nil), buf[:n]...)}
+			if first {
+				chunk.Format = archiveFormat
+				chunk.ConflictPolicy = policy
+				first = false
+			}
+			if err := stream.Send(chunk); err != nil {
+				// The server closed the stream; its status is returned by CloseAndRecv.
+				break
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read archive: %w", err)
+		}
+	}
+
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+	return c.printImportResults(res)
+}
@@ -0,0 +1,285 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	pb "memo/grpc"
+)
+
+// stringList is a flag that may be repeated.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+// stringMap is a flag of key=value pairs that may be repeated.
+type stringMap map[string]string
+
+func (m stringMap) String() string {
+	pairs := make([]string, 0, len(m))
+	for key, value := range m {
+		pairs = append(pairs, key+"="+value)
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (m stringMap) Set(value string) error {
+	key, val, ok := strings.Cut(value, "=")
+	if !ok || key == "" {
+		return fmt.Errorf("%q is not in the form name=value", value)
+	}
+	m[key] = val
+	return nil
+}
+
+func runCreate(c *client, args []string) error {
+	fs := newFlagSet("create", "", "Create a Markdown memo. With --template, the content is rendered from the template.")
+	title := fs.String("title", "", "title of the memo (required)")
+	notebook := fs.String("notebook", "", "notebook to create the memo in")
+	content := fs.String("content", "", "content of the memo")
+	template := fs.String("template", "", "ID of the template to render the content from")
+	variables := stringMap{}
+	fs.Var(variables, "var", "template variable as name=value (repeatable)")
+	remindAt := fs.String("remind-at", "", "time to be reminded of the memo")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	if len(args) != 0 || *title == "" {
+		fs.Usage()
+		return errUsage
+	}
+
+	req := &pb.CreateMemoRequest{
+		Title:      *title,
+		Notebook:   *notebook,
+		TemplateId: *template,
+		Variables:  variables,
+	}
+	if *template == "" {
+		req.Content, err = readContent(*content, isFlagSet(fs, "content"), ".md")
+		if err != nil {
+			return err
+		}
+	}
+	if *remindAt != "" {
+		if req.RemindAt, err = parseTime(*remindAt); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.memo.CreateMemo(ctx, req)
+	if err != nil {
+		return err
+	}
+	return c.printMemo(res, res.Memo)
+}
+
+func runCreateJson(c *client, args []string) error {
+	fs := newFlagSet("create-json", "", "Create a JSON memo. The content must be a JSON document.")
+	title := fs.String("title", "", "title of the memo (required)")
+	content := fs.String("content", "", "JSON content of the memo")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	if len(args) != 0 || *title == "" {
+		fs.Usage()
+		return errUsage
+	}
+
+	text, err := readContent(*content, isFlagSet(fs, "content"), ".json")
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.memo.CreateMemoByJson(ctx, &pb.CreateMemoByJsonRequest{
+		Title:   *title,
+		Content: text,
+	})
+	if err != nil {
+		return err
+	}
+	return c.printMemo(res, res.Memo)
+}
+
+func runGet(c *client, args []string) error {
+	fs := newFlagSet("get", "<id>", "Show a memo.")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	if len(args) != 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.memo.GetMemo(ctx, &pb.GetMemoRequest{Id: args[0]})
+	if err != nil {
+		return err
+	}
+	return c.printMemo(res, res.Memo)
+}
+
+func runGetMulti(c *client, args []string) error {
+	fs := newFlagSet("get-multi", "<id>...", "Show several memos.")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	if len(args) == 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.memo.GetMultiMemos(ctx, &pb.GetMultiMemoRequest{MemoIds: args})
+	if err != nil {
+		return err
+	}
+	return c.printMemos(res, res.Memos)
+}
+
+func runList(c *client, args []string) error {
+	fs := newFlagSet("list", "", "List memos.")
+	var tags stringList
+	fs.Var(&tags, "tag", "only list memos with this tag (repeatable)")
+	notebook := fs.String("notebook", "", "only list memos in this notebook")
+	recursive := fs.Bool("recursive", false, "include sub-notebooks of --notebook")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	if len(args) != 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	req := &pb.ListMemosRequest{
+		Tags:      tags,
+		Recursive: *recursive,
+	}
+	if isFlagSet(fs, "notebook") {
+		req.Notebook = notebook
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.memo.ListMemos(ctx, req)
+	if err != nil {
+		return err
+	}
+	return c.printMemos(res, res.Memos)
+}
+
+func runUpdate(c *client, args []string) error {
+	fs := newFlagSet("update", "<id>", "Update a memo. Without --content, stdin or $EDITOR is used;\nthe editor starts with the current content.")
+	title := fs.String("title", "", "new title of the memo")
+	content := fs.String("content", "", "new content of the memo")
+	remindAt := fs.String("remind-at", "", "time to be reminded of the memo")
+	clearReminder := fs.Bool("clear-reminder", false, "remove the reminder of the memo")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	if len(args) != 1 {
+		fs.Usage()
+		return errUsage
+	}
+	id := args[0]
+
+	req := &pb.UpdateMemoRequest{Id: id, ClearReminder: *clearReminder}
+	if isFlagSet(fs, "title") {
+		req.Title = title
+	}
+	if *remindAt != "" {
+		if req.RemindAt, err = parseTime(*remindAt); err != nil {
+			return err
+		}
+	}
+	metadataOnly := isFlagSet(fs, "title") || *remindAt != "" || *clearReminder
+
+	switch {
+	case isFlagSet(fs, "content"):
+		req.Content = *content
+	case !stdinIsTerminal():
+		text, err := readStdin()
+		if err != nil {
+			return err
+		}
+		req.Content = text
+	case metadataOnly:
+		// Changing only the title or reminder keeps the current content.
+	default:
+		current, err := c.currentContent(id)
+		if err != nil {
+			return err
+		}
+		text, err := editContent(current, ".md")
+		if err != nil {
+			return err
+		}
+		req.Content = text
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.memo.UpdateMemo(ctx, req)
+	if err != nil {
+		return err
+	}
+	return c.printMemo(res, res.Memo)
+}
+
+// currentContent fetches the content of a memo to seed the editor with.
+func (c *client) currentContent(id string) (string, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.memo.GetMemo(ctx, &pb.GetMemoRequest{Id: id})
+	if err != nil {
+		return "", err
+	}
+	return res.Memo.GetContent(), nil
+}
+
+func runUsage(c *client, args []string) error {
+	fs := newFlagSet("usage", "", "Show the storage used and the quota limits.")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	if len(args) != 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.memo.GetUsage(ctx, &pb.GetUsageRequest{})
+	if err != nil {
+		return err
+	}
+	return c.printUsage(res)
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	pb "memo/grpc"
+)
+
+func runHistory(c *client, args []string) error {
+	fs := newFlagSet("history", "<id>", "Show the commits that changed a memo, newest first.")
+	limit := fs.Int("limit", 0, "maximum number of commits to show (default chosen by the server)")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	if len(args) != 1 || *limit < 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.memo.GetMemoHistory(ctx, &pb.GetMemoHistoryRequest{Id: args[0], Limit: int32(*limit)})
+	if err != nil {
+		return err
+	}
+	return c.printCommits(res, res.Commits)
+}
+
+func runRevert(c *client, args []string) error {
+	fs := newFlagSet("revert", "<id> <commit>", "Restore a memo to its content at a commit from its history.")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	if len(args) != 2 {
+		fs.Usage()
+		return errUsage
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.memo.RevertMemo(ctx, &pb.RevertMemoRequest{Id: args[0], CommitHash: args[1]})
+	if err != nil {
+		return err
+	}
+	return c.printMemo(res, res.Memo)
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// readContent returns the content of a memo: the --content flag when set,
+// then stdin when it is piped, and otherwise the text written in $EDITOR.
+func readContent(flagValue string, flagSet bool, ext string) (string, error) {
+	if flagSet {
+		return flagValue, nil
+	}
+	if !stdinIsTerminal() {
+		return readStdin()
+	}
+	return editContent("", ext)
+}
+
+func readStdin() (string, error) {
+	data, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return "", fmt.Errorf("failed to read stdin: %w", err)
+	}
+	return string(data), nil
+}
+
+// stdinIsTerminal reports whether stdin is an interactive terminal rather than a pipe or file.
+func stdinIsTerminal() bool {
+	info, err := os.Stdin.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// editContent opens $EDITOR (or vi) on a temporary file holding initial
+// and returns what was saved. An empty result aborts the command.
+func editContent(initial, ext string) (string, error) {
+	editor := strings.Fields(os.Getenv("EDITOR"))
+	if len(editor) == 0 {
+		editor = []string{"vi"}
+	}
+
+	file, err := os.CreateTemp("", "memo-*"+ext)
+	if err != nil {
+		return "", fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.WriteString(initial); err != nil {
+		file.Close()
+		return "", fmt.Errorf("failed to write temporary file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return "", fmt.Errorf("failed to write temporary file: %w", err)
+	}
+
+	cmd := exec.Command(editor[0], append(editor[1:], file.Name())...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("editor %s failed: %w", editor[0], err)
+	}
+
+	data, err := os.ReadFile(file.Name())
+	if err != nil {
+		return "", fmt.Errorf("failed to read temporary file: %w", err)
+	}
+	if strings.TrimSpace(string(data)) == "" {
+		return "", errors.New("aborted: content is empty")
+	}
+	return string(data), nil
+}
+
+// parseTime parses a time given on the command line, either as RFC 3339 or
+// as "2006-01-02 15:04:05" in local time.
+func parseTime(value string) (*timestamppb.Timestamp, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t, err = time.ParseInLocation(time.DateTime, value, time.Local)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid time %q: use RFC 3339 or %q", value, time.DateTime)
+	}
+	return timestamppb.New(t), nil
+}
+
+// stdoutIsTerminal reports whether stdout is an interactive terminal rather than a pipe or file.
+func stdoutIsTerminal() bool {
+	info, err := os.Stdout.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
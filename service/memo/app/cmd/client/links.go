@@ -0,0 +1,68 @@
+package main
+
+import (
+	pb "memo/grpc"
+)
+
+func runTags(c *client, args []string) error {
+	fs := newFlagSet("tags", "", "List tags with the number of memos using them.")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	if len(args) != 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.memo.ListMemoTags(ctx, &pb.ListMemoTagsRequest{})
+	if err != nil {
+		return err
+	}
+	return c.printTags(res)
+}
+
+func runLinks(c *client, args []string) error {
+	fs := newFlagSet("links", "<id>", "Show the memos a memo links to, the memos linking to it\nand the links that match no memo.")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	if len(args) != 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.memo.GetMemoLinks(ctx, &pb.GetMemoLinksRequest{Id: args[0]})
+	if err != nil {
+		return err
+	}
+	return c.printLinks(res)
+}
+
+func runGraph(c *client, args []string) error {
+	fs := newFlagSet("graph", "", "Show the links between all memos.")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	if len(args) != 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.memo.GetMemoGraph(ctx, &pb.GetMemoGraphRequest{})
+	if err != nil {
+		return err
+	}
+	return c.printGraph(res)
+}
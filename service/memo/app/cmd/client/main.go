@@ -2,38 +2,202 @@ package main
 
 import (
 	"context"
-	"log"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
 	"time"
 
 	pb "memo/grpc"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 )
 
+const usage = `usage: client [global flags] <command> [flags] [args]
+
+Commands:
+  create           create a Markdown memo
+  create-json      create a JSON memo
+  get              show a memo
+  get-multi        show several memos
+  list             list memos
+  update           update the content, title or reminder of a memo
+  export           export memos to an archive
+  import           import memos from an archive
+  tags             list tags with the number of memos using them
+  links            show the links of a memo and its backlinks
+  graph            show the links between all memos
+  history          show the commits that changed a memo
+  revert           restore a memo to its content at a commit
+  notebooks        list notebooks
+  create-notebook  create a notebook
+  move             move a memo to another notebook
+  delete-notebook  delete a notebook
+  watch            follow the live editing session of a memo
+  reminders        stream reminders as they fall due
+  snooze           snooze the reminder of a memo
+  dismiss          dismiss the reminder of a memo
+  templates        list memo templates
+  create-template  create a memo template
+  delete-template  delete a memo template
+  usage            show the storage used and the quota limits
+
+Content for create, create-json, update and create-template is taken from
+--content, then stdin when it is not a terminal, and otherwise from $EDITOR.
+Times are given as RFC 3339 or "2006-01-02 15:04:05" in local time.
+
+Global flags:
+`
+
+// errUsage is returned by commands whose arguments are invalid.
+var errUsage = errors.New("invalid usage")
+
+// client holds the connection and the global flags shared by every command.
+type client struct {
+	memo    pb.MemoServiceClient
+	addr    string
+	timeout time.Duration
+	token   string
+	output  string
+}
+
+// command runs a subcommand with its own arguments.
+type command func(c *client, args []string) error
+
+var commands = map[string]command{
+	"create":          runCreate,
+	"create-json":     runCreateJson,
+	"get":             runGet,
+	"get-multi":       runGetMulti,
+	"list":            runList,
+	"update":          runUpdate,
+	"export":          runExport,
+	"import":          runImport,
+	"tags":            runTags,
+	"links":           runLinks,
+	"graph":           runGraph,
+	"history":         runHistory,
+	"revert":          runRevert,
+	"notebooks":       runNotebooks,
+	"create-notebook": runCreateNotebook,
+	"move":            runMove,
+	"delete-notebook": runDeleteNotebook,
+	"watch":           runWatch,
+	"reminders":       runReminders,
+	"snooze":          runSnooze,
+	"dismiss":         runDismiss,
+	"templates":       runTemplates,
+	"create-template": runCreateTemplate,
+	"delete-template": runDeleteTemplate,
+	"usage":           runUsage,
+}
+
 func main() {
-	conn, err := grpc.Dial(
-		"localhost:8080",
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithBlock(),
-	)
+	addr := flag.String("addr", "localhost:8080", "address of the memo server")
+	timeout := flag.Duration("timeout", 5*time.Second, "timeout of each request")
+	output := flag.String("output", "table", "output format: table or json")
+	token := flag.String("token", os.Getenv("MEMO_TOKEN"), "bearer token sent to the server (default $MEMO_TOKEN)")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	run, ok := commands[flag.Arg(0)]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n", flag.Arg(0))
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *output != "table" && *output != "json" {
+		fmt.Fprintf(os.Stderr, "unknown output format %q: use table or json\n", *output)
+		os.Exit(2)
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
-		log.Fatalf("did not connect: %v", err)
+		fmt.Fprintf(os.Stderr, "error: failed to connect to %s: %v\n", *addr, err)
+		os.Exit(1)
 	}
 	defer conn.Close()
 
-	c := pb.NewMemoServiceClient(conn)
+	c := &client{
+		memo:    pb.NewMemoServiceClient(conn),
+		addr:    *addr,
+		timeout: *timeout,
+		token:   *token,
+		output:  *output,
+	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-	defer cancel()
+	if err := run(c, flag.Args()[1:]); err != nil {
+		if errors.Is(err, errUsage) {
+			os.Exit(2)
+		}
+		fmt.Fprintf(os.Stderr, "error: %s\n", c.describeError(err))
+		os.Exit(1)
+	}
+}
 
-	res, err := c.CreateMemo(ctx, &pb.CreateMemoRequest{
-		Title:   "Test Title",
-		Content: "Test Content",
-	})
-	if err != nil {
-		log.Fatalf("could not create memo: %v", err)
+// context returns a context for a single request with the timeout and token applied.
+func (c *client) context() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	if c.token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+c.token)
+	}
+	return ctx, cancel
+}
+
+// streamContext returns a context for a stream that runs until it ends or
+// the user interrupts it, so the timeout does not apply.
+func (c *client) streamContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	if c.token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+c.token)
 	}
+	return ctx, cancel
+}
+
+// newFlagSet creates the flag set of a command. Parse errors are reported by
+// the flag package, so commands return errUsage for them.
+func newFlagSet(name, args, description string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: client %s [flags] %s\n\n%s\n", name, args, description)
+		fs.PrintDefaults()
+	}
+	return fs
+}
 
-	log.Printf("Created Memo: %v", res.GetMemo())
+// parseFlags parses args allowing flags after positional arguments,
+// as in "update <id> --title x", and returns the positional arguments.
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+// isFlagSet reports whether the flag name was given on the command line.
+func isFlagSet(fs *flag.FlagSet, name string) bool {
+	set := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
 }
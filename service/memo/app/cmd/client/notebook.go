@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+
+	pb "memo/grpc"
+)
+
+func runNotebooks(c *client, args []string) error {
+	fs := newFlagSet("notebooks", "", "List notebooks with the number of memos directly in each.")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	if len(args) != 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.memo.ListNotebooks(ctx, &pb.ListNotebooksRequest{})
+	if err != nil {
+		return err
+	}
+	return c.printNotebooks(res)
+}
+
+func runCreateNotebook(c *client, args []string) error {
+	fs := newFlagSet("create-notebook", "<path>", "Create a notebook, including any missing parents.")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	if len(args) != 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.memo.CreateNotebook(ctx, &pb.CreateNotebookRequest{Path: args[0]})
+	if err != nil {
+		return err
+	}
+	if c.output == "json" {
+		return printJson(res)
+	}
+	fmt.Printf("Created notebook %s\n", res.GetNotebook().GetPath())
+	return nil
+}
+
+func runMove(c *client, args []string) error {
+	fs := newFlagSet("move", "<id> <notebook>", "Move a memo to another notebook. Use \"\" for the root of the folder.")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	if len(args) != 2 {
+		fs.Usage()
+		return errUsage
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.memo.MoveMemo(ctx, &pb.MoveMemoRequest{Id: args[0], Notebook: args[1]})
+	if err != nil {
+		return err
+	}
+	return c.printMemo(res, res.Memo)
+}
+
+func runDeleteNotebook(c *client, args []string) error {
+	fs := newFlagSet("delete-notebook", "<path>", "Delete a notebook. Unless --recursive is set, it must be empty.")
+	recursive := fs.Bool("recursive", false, "also delete the memos and notebooks inside")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	if len(args) != 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.memo.DeleteNotebook(ctx, &pb.DeleteNotebookRequest{Path: args[0], Recursive: *recursive})
+	if err != nil {
+		return err
+	}
+	if c.output == "json" {
+		return printJson(res)
+	}
+	fmt.Printf("Deleted notebook %s\n", args[0])
+	return nil
+}
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	pb "memo/grpc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// printMemo prints a single memo with its content, or res as JSON.
+func (c *client) printMemo(res proto.Message, memo *pb.Memo) error {
+	if c.output == "json" {
+		return printJson(res)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "ID:\t%s\n", memo.GetId())
+	fmt.Fprintf(w, "Title:\t%s\n", memo.GetTitle())
+	if memo.GetNotebook() != "" {
+		fmt.Fprintf(w, "Notebook:\t%s\n", memo.GetNotebook())
+	}
+	if len(memo.GetTags()) > 0 {
+		fmt.Fprintf(w, "Tags:\t%s\n", strings.Join(memo.GetTags(), ", "))
+	}
+	fmt.Fprintf(w, "Created:\t%s\n", formatTime(memo.GetCreatedAt()))
+	fmt.Fprintf(w, "Updated:\t%s\n", formatTime(memo.GetUpdatedAt()))
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	fmt.Println(strings.TrimRight(memo.GetContent(), "\n"))
+	return nil
+}
+
+// printMemos prints memos as a table without their content, or res as JSON.
+func (c *client) printMemos(res proto.Message, memos []*pb.Memo) error {
+	if c.output == "json" {
+		return printJson(res)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tTITLE\tNOTEBOOK\tTAGS\tUPDATED")
+	for _, memo := range memos {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			memo.GetId(),
+			memo.GetTitle(),
+			memo.GetNotebook(),
+			strings.Join(memo.GetTags(), ","),
+			formatTime(memo.GetUpdatedAt()),
+		)
+	}
+	return w.Flush()
+}
+
+// printUsage prints the usage against each limit, or res as JSON.
+func (c *client) printUsage(res *pb.GetUsageResponse) error {
+	if c.output == "json" {
+		return printJson(res)
+	}
+
+	limits := res.GetLimits()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Scope:\t%s\n", res.GetScope())
+	fmt.Fprintf(w, "Memos:\t%d / %s\n", res.GetMemoCount(), formatLimit(limits.GetMaxMemos()))
+	fmt.Fprintf(w, "Bytes:\t%d / %s\n", res.GetTotalBytes(), formatLimit(limits.GetMaxTotalBytes()))
+	fmt.Fprintf(w, "Max memo size:\t%s\n", formatLimit(limits.GetMaxContentBytes()))
+	return w.Flush()
+}
+
+func formatLimit(limit int64) string {
+	if limit <= 0 {
+		return "unlimited"
+	}
+	return strconv.FormatInt(limit, 10)
+}
+
+func printJson(res proto.Message) error {
+	data, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(res)
+	if err != nil {
+		return fmt.Errorf("failed to encode response: %w", err)
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+// formatTime formats a timestamp in local time. The server leaves unknown
+// timestamps at the zero time, which is shown as "-".
+func formatTime(ts *timestamppb.Timestamp) string {
+	if ts == nil || ts.AsTime().IsZero() || ts.AsTime().Unix() <= 0 {
+		return "-"
+	}
+	return ts.AsTime().Local().Format(time.DateTime)
+}
+
+// describeError turns a gRPC error into a message for the user.
+func (c *client) describeError(err error) string {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err.Error()
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		return fmt.Sprintf("not found: %s", st.Message())
+	case codes.InvalidArgument:
+		return fmt.Sprintf("invalid request: %s", st.Message())
+	case codes.AlreadyExists:
+		return fmt.Sprintf("already exists: %s", st.Message())
+	case codes.FailedPrecondition:
+		return fmt.Sprintf("cannot do that now: %s", st.Message())
+	case codes.Unauthenticated:
+		return fmt.Sprintf("not authenticated, set --token or MEMO_TOKEN: %s", st.Message())
+	case codes.PermissionDenied:
+		return fmt.Sprintf("permission denied: %s", st.Message())
+	case codes.ResourceExhausted:
+		return fmt.Sprintf("quota exceeded, see the usage command: %s", st.Message())
+	case codes.DeadlineExceeded:
+		return fmt.Sprintf("request timed out after %s, try a larger --timeout", c.timeout)
+	case codes.Unavailable:
+		return fmt.Sprintf("memo server at %s is unavailable: %s", c.addr, st.Message())
+	case codes.Unimplemented:
+		return fmt.Sprintf("the server does not support this command: %s", st.Message())
+	default:
+		return fmt.Sprintf("%s: %s", st.Code(), st.Message())
+	}
+}
+
+// printImportResults prints the outcome of each imported memo, or res as JSON.
+func (c *client) printImportResults(res *pb.ImportMemosResponse) error {
+	if c.output == "json" {
+		return printJson(res)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tTITLE\tSTATUS\tNEW ID\tERROR")
+	for _, result := range res.GetResults() {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			result.GetId(),
+			result.GetTitle(),
+			strings.ToLower(strings.TrimPrefix(result.GetStatus().String(), "IMPORT_STATUS_")),
+			result.GetNewId(),
+			result.GetError(),
+		)
+	}
+	return w.Flush()
+}
+
+// printTags prints each tag with its memo count, or res as JSON.
+func (c *client) printTags(res *pb.ListMemoTagsResponse) error {
+	if c.output == "json" {
+		return printJson(res)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TAG\tMEMOS")
+	for _, tag := range res.GetTags() {
+		fmt.Fprintf(w, "%s\t%d\n", tag.GetTag(), tag.GetCount())
+	}
+	return w.Flush()
+}
+
+// printLinks prints the links of a memo grouped by direction, or res as JSON.
+func (c *client) printLinks(res *pb.GetMemoLinksResponse) error {
+	if c.output == "json" {
+		return printJson(res)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "DIRECTION\tID\tTITLE")
+	for _, ref := range res.GetOutgoing() {
+		fmt.Fprintf(w, "outgoing\t%s\t%s\n", ref.GetId(), ref.GetTitle())
+	}
+	for _, ref := range res.GetBacklinks() {
+		fmt.Fprintf(w, "backlink\t%s\t%s\n", ref.GetId(), ref.GetTitle())
+	}
+	for _, target := range res.GetUnresolved() {
+		fmt.Fprintf(w, "unresolved\t-\t%s\n", target)
+	}
+	return w.Flush()
+}
+
+// printGraph prints one row per link with the titles of both memos, or res as JSON.
+func (c *client) printGraph(res *pb.GetMemoGraphResponse) error {
+	if c.output == "json" {
+		return printJson(res)
+	}
+
+	titles := make(map[string]string, len(res.GetNodes()))
+	for _, node := range res.GetNodes() {
+		titles[node.GetId()] = node.GetTitle()
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SOURCE\tTARGET")
+	for _, edge := range res.GetEdges() {
+		fmt.Fprintf(w, "%s (%s)\t%s (%s)\n",
+			titles[edge.GetSourceId()], edge.GetSourceId(),
+			titles[edge.GetTargetId()], edge.GetTargetId(),
+		)
+	}
+	return w.Flush()
+}
+
+// printCommits prints commits as a table, or res as JSON.
+func (c *client) printCommits(res proto.Message, commits []*pb.MemoCommit) error {
+	if c.output == "json" {
+		return printJson(res)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "COMMIT\tDATE\tAUTHOR\tMESSAGE")
+	for _, commit := range commits {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			commit.GetHash(),
+			formatTime(commit.GetCommittedAt()),
+			commit.GetAuthorName(),
+			commit.GetMessage(),
+		)
+	}
+	return w.Flush()
+}
+
+// printNotebooks prints notebooks with their memo counts, or res as JSON.
+func (c *client) printNotebooks(res *pb.ListNotebooksResponse) error {
+	if c.output == "json" {
+		return printJson(res)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NOTEBOOK\tMEMOS")
+	for _, notebook := range res.GetNotebooks() {
+		fmt.Fprintf(w, "%s\t%d\n", notebook.GetPath(), notebook.GetMemoCount())
+	}
+	return w.Flush()
+}
+
+// printReminder prints a reminder on a single line, or as JSON.
+func (c *client) printReminder(reminder *pb.Reminder) error {
+	if c.output == "json" {
+		return printJson(reminder)
+	}
+
+	title := "(deleted memo)"
+	if reminder.GetMemo() != nil {
+		title = reminder.GetMemo().GetTitle()
+	}
+	fmt.Printf("%s  %s  %s\n", formatTime(reminder.GetRemindAt()), reminder.GetMemoId(), title)
+	return nil
+}
+
+// printTemplates prints templates as a table without their content, or res as JSON.
+func (c *client) printTemplates(res *pb.ListMemoTemplatesResponse) error {
+	if c.output == "json" {
+		return printJson(res)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tUPDATED")
+	for _, template := range res.GetTemplates() {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", template.GetId(), template.GetName(), formatTime(template.GetUpdatedAt()))
+	}
+	return w.Flush()
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	pb "memo/grpc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func runReminders(c *client, args []string) error {
+	fs := newFlagSet("reminders", "", "Print reminders as they fall due, starting with those already due.\nStop with Ctrl-C.")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	if len(args) != 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	ctx, cancel := c.streamContext()
+	defer cancel()
+
+	stream, err := c.memo.StreamReminders(ctx, &pb.StreamRemindersRequest{})
+	if err != nil {
+		return err
+	}
+	for {
+		reminder, err := stream.Recv()
+		if err == io.EOF || interrupted(ctx, err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := c.printReminder(reminder); err != nil {
+			return err
+		}
+	}
+}
+
+func runSnooze(c *client, args []string) error {
+	fs := newFlagSet("snooze", "<id>", "Snooze the reminder of a memo. Give either --for or --until.")
+	duration := fs.Duration("for", 0, "snooze for this long from now, such as 10m or 1h")
+	until := fs.String("until", "", "time to be reminded again")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	if len(args) != 1 || (*duration == 0) == (*until == "") {
+		fs.Usage()
+		return errUsage
+	}
+
+	req := &pb.SnoozeReminderRequest{MemoId: args[0]}
+	if *until != "" {
+		remindAt, err := parseTime(*until)
+		if err != nil {
+			return err
+		}
+		req.Until = &pb.SnoozeReminderRequest_RemindAt{RemindAt: remindAt}
+	} else {
+		req.Until = &pb.SnoozeReminderRequest_Duration{Duration: durationpb.New(*duration)}
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.memo.SnoozeReminder(ctx, req)
+	if err != nil {
+		return err
+	}
+	if c.output == "json" {
+		return printJson(res)
+	}
+	fmt.Printf("Snoozed %s until %s\n", args[0], formatTime(res.GetReminder().GetRemindAt()))
+	return nil
+}
+
+func runDismiss(c *client, args []string) error {
+	fs := newFlagSet("dismiss", "<id>", "Dismiss the reminder of a memo.")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	if len(args) != 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.memo.DismissReminder(ctx, &pb.DismissReminderRequest{MemoId: args[0]})
+	if err != nil {
+		return err
+	}
+	if c.output == "json" {
+		return printJson(res)
+	}
+	fmt.Printf("Dismissed the reminder of %s\n", args[0])
+	return nil
+}
+
+// interrupted reports whether a stream ended because the user stopped the command.
+func interrupted(ctx context.Context, err error) bool {
+	return ctx.Err() != nil && (errors.Is(err, context.Canceled) || status.Code(err) == codes.Canceled)
+}
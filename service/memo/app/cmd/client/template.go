@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+
+	pb "memo/grpc"
+)
+
+func runTemplates(c *client, args []string) error {
+	fs := newFlagSet("templates", "", "List memo templates.")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	if len(args) != 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.memo.ListMemoTemplates(ctx, &pb.ListMemoTemplatesRequest{})
+	if err != nil {
+		return err
+	}
+	return c.printTemplates(res)
+}
+
+func runCreateTemplate(c *client, args []string) error {
+	fs := newFlagSet("create-template", "", "Create a memo template. The content is a Go text/template;\nbesides the values of create --var, it can use {{.Title}}, {{.Date}}, {{.User}} and more.")
+	name := fs.String("name", "", "name of the template (required)")
+	content := fs.String("content", "", "content of the template")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	if len(args) != 0 || *name == "" {
+		fs.Usage()
+		return errUsage
+	}
+
+	text, err := readContent(*content, isFlagSet(fs, "content"), ".md")
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.memo.CreateMemoTemplate(ctx, &pb.CreateMemoTemplateRequest{Name: *name, Content: text})
+	if err != nil {
+		return err
+	}
+	if c.output == "json" {
+		return printJson(res)
+	}
+	fmt.Printf("Created template %s (%s)\n", res.GetTemplate().GetName(), res.GetTemplate().GetId())
+	return nil
+}
+
+func runDeleteTemplate(c *client, args []string) error {
+	fs := newFlagSet("delete-template", "<id>", "Delete a memo template.")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	if len(args) != 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	ctx, cancel := c.context()
+	defer cancel()
+
+	res, err := c.memo.DeleteMemoTemplate(ctx, &pb.DeleteMemoTemplateRequest{Id: args[0]})
+	if err != nil {
+		return err
+	}
+	if c.output == "json" {
+		return printJson(res)
+	}
+	fmt.Printf("Deleted template %s\n", args[0])
+	return nil
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"memo/collab"
+	pb "memo/grpc"
+)
+
+func runWatch(c *client, args []string) error {
+	fs := newFlagSet("watch", "<id>", "Join the live editing session of a memo without editing, and print\nthe document whenever it changes. Stop with Ctrl-C.")
+	name := fs.String("name", "", "name shown to the other participants (default the authenticated user)")
+	args, err := parseFlags(fs, args)
+	if err != nil {
+		return errUsage
+	}
+	if len(args) != 1 {
+		fs.Usage()
+		return errUsage
+	}
+
+	ctx, cancel := c.streamContext()
+	defer cancel()
+
+	stream, err := c.memo.EditSession(ctx)
+	if err != nil {
+		return err
+	}
+	err = stream.Send(&pb.EditOp{
+		MemoId:  args[0],
+		Payload: &pb.EditOp_Join{Join: &pb.EditJoin{Name: *name}},
+	})
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	var content string
+	for {
+		op, err := stream.Recv()
+		if err == io.EOF || interrupted(ctx, err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if c.output == "json" {
+			if err := printJson(op); err != nil {
+				return err
+			}
+			continue
+		}
+
+		switch payload := op.Payload.(type) {
+		case *pb.EditOp_Snapshot:
+			content = payload.Snapshot.GetContent()
+			fmt.Fprintf(os.Stderr, "joined as %s\n", op.GetName())
+			for _, participant := range payload.Snapshot.GetParticipants() {
+				if participant.GetClientId() != op.GetClientId() {
+					fmt.Fprintf(os.Stderr, "%s is editing\n", participant.GetName())
+				}
+			}
+			printRevision(op, content)
+		case *pb.EditOp_Operation:
+			content, err = convertOperation(payload.Operation).Apply(content)
+			if err != nil {
+				return fmt.Errorf("failed to apply edit from %s: %w", op.GetName(), err)
+			}
+			printRevision(op, content)
+		case *pb.EditOp_Presence:
+			if payload.Presence.GetOnline() {
+				fmt.Fprintf(os.Stderr, "%s joined\n", op.GetName())
+			} else {
+				fmt.Fprintf(os.Stderr, "%s left\n", op.GetName())
+			}
+		}
+	}
+}
+
+// printRevision prints the document after a snapshot or an edit.
+func printRevision(op *pb.EditOp, content string) {
+	fmt.Printf("--- revision %d by %s ---\n", op.GetRevision(), op.GetName())
+	fmt.Println(strings.TrimRight(content, "\n"))
+}
+
+// convertOperation converts an operation from the server into one that can be applied.
+func convertOperation(operation *pb.TextOperation) collab.Operation {
+	var result collab.Operation
+	for _, c := range operation.GetComponents() {
+		switch component := c.Component.(type) {
+		case *pb.TextComponent_Retain:
+			result = result.Retain(int(component.Retain))
+		case *pb.TextComponent_Insert:
+			result = result.Insert(component.Insert)
+		case *pb.TextComponent_Delete:
+			result = result.Delete(int(component.Delete))
+		}
+	}
+	return result
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	config "memo/config/server"
+	"memo/db"
+)
+
+// fsck checks the memo folder for files the server cannot serve.
+// It works offline on the folder from config.yml, so stop the server before repairing.
+func main() {
+	repair := flag.Bool("repair", false, "rename or rewrite files that can be fixed in place")
+	quarantine := flag.Bool("quarantine", false, "move files that cannot be repaired into "+db.QuarantineDir)
+	flag.Parse()
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	fs, err := db.GetService(cfg)
+	if err != nil {
+		log.Fatalf("failed to open memo folder: %v", err)
+	}
+
+	report, err := fs.CheckIntegrity(db.IntegrityOptions{
+		Repair:     *repair,
+		Quarantine: *quarantine,
+	})
+	if err != nil {
+		log.Fatalf("failed to check integrity: %v", err)
+	}
+
+	for _, issue := range report.Issues {
+		fmt.Printf("%-16s %-12s %s: %s\n", issue.Kind, issue.Action, issue.Path, issue.Detail)
+	}
+	fmt.Printf("scanned %d files, found %d issues in %s\n", report.ScannedFiles, len(report.Issues), cfg.FolderPath)
+
+	// Exit with 1 when issues remain, like fsck does.
+	for _, issue := range report.Issues {
+		if issue.Action != db.ActionRepaired && issue.Action != db.ActionQuarantined {
+			os.Exit(1)
+		}
+	}
+}
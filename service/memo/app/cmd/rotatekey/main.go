@@ -0,0 +1,67 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	config "memo/config/server"
+	"memo/db"
+	"memo/encryption"
+)
+
+// rotatekey re-encrypts the memo folder with a new key.
+// The current key is taken from config.yml; the new one from the flags below.
+func main() {
+	newKeyFile := flag.String("new-key-file", "", "path of the new key file")
+	generate := flag.Bool("generate", false, "generate a new random key at -new-key-file")
+	newPassphrase := flag.String("new-passphrase", "", "new passphrase (used when -new-key-file is empty)")
+	decrypt := flag.Bool("decrypt", false, "write the folder back as plaintext")
+	flag.Parse()
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	oldCipher, err := encryption.New(cfg.Encryption)
+	if err != nil {
+		log.Fatalf("failed to load current key: %v", err)
+	}
+
+	var newCipher *encryption.Cipher
+	switch {
+	case *decrypt:
+		// newCipher stays nil.
+	case *newKeyFile != "":
+		var key []byte
+		if *generate {
+			key, err = encryption.GenerateKeyFile(*newKeyFile)
+		} else {
+			key, err = encryption.LoadKeyFile(*newKeyFile)
+		}
+		if err != nil {
+			log.Fatalf("failed to load new key: %v", err)
+		}
+		newCipher, err = encryption.NewWithKey(key)
+		if err != nil {
+			log.Fatalf("invalid new key: %v", err)
+		}
+	case *newPassphrase != "":
+		newCipher, err = encryption.NewWithPassphrase(*newPassphrase)
+		if err != nil {
+			log.Fatalf("invalid new passphrase: %v", err)
+		}
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	count, err := db.RotateKey(cfg.FolderPath, oldCipher, newCipher)
+	if err != nil {
+		log.Fatalf("key rotation stopped after %d files: %v (rerun with the same new key, without -generate, to finish)", count, err)
+	}
+
+	log.Printf("re-encrypted %d memo files in %s", count, cfg.FolderPath)
+	log.Println("update the encryption settings in config.yml before restarting the server")
+}
@@ -1,15 +1,18 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
 	"os"
 	"os/signal"
 
+	"memo/auth"
 	config "memo/config/server"
 	pb "memo/grpc"
 	"memo/service"
+	"memo/snapshot"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -28,9 +31,49 @@ func main() {
 		panic(err)
 	}
 
-	s := grpc.NewServer()
+	memoService, err := service.NewMemoService(cfg)
+	if err != nil {
+		log.Fatalf("failed to create memo service: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go memoService.RunReminders(ctx)
+
+	var snapshots *snapshot.Manager
+	if cfg.Snapshot.Enabled {
+		if cfg.Snapshot.Destination == "" || cfg.Snapshot.Interval <= 0 {
+			log.Fatalf("snapshot.destination and a positive snapshot.interval are required")
+		}
+		snapshots = snapshot.New(cfg.FolderPath, cfg.Snapshot.Destination, snapshot.Retention{
+			Hourly: cfg.Snapshot.Retention.Hourly,
+			Daily:  cfg.Snapshot.Retention.Daily,
+			Weekly: cfg.Snapshot.Retention.Weekly,
+		})
+		go snapshots.Run(ctx, cfg.Snapshot.Interval)
+		log.Printf("snapshots enabled (every %s into %s)", cfg.Snapshot.Interval, cfg.Snapshot.Destination)
+	}
+
+	verifier, err := auth.NewVerifier(cfg.Auth)
+	if err != nil {
+		log.Fatalf("failed to set up authentication: %v", err)
+	}
+
+	var opts []grpc.ServerOption
+	if verifier != nil {
+		defer verifier.Close()
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(auth.UnaryServerInterceptor(verifier, cfg.Auth.AdminUserIDs)),
+			grpc.ChainStreamInterceptor(auth.StreamServerInterceptor(verifier, cfg.Auth.AdminUserIDs)),
+		)
+		log.Printf("authentication enabled (mode: %s)", cfg.Auth.Mode)
+	}
+
+	s := grpc.NewServer(opts...)
 
-	pb.RegisterMemoServiceServer(s, service.NewMemoService(cfg))
+	pb.RegisterMemoServiceServer(s, memoService)
+	pb.RegisterMemoAdminServiceServer(s, service.NewAdminService(memoService, snapshots))
 
 	reflection.Register(s)
 
@@ -43,5 +86,7 @@ func main() {
 	signal.Notify(quit, os.Interrupt)
 	<-quit
 	log.Println("stopping gRPC server...")
+	// Edit sessions and reminder feeds are long-lived streams; end them so that GracefulStop can finish.
+	memoService.Close()
 	s.GracefulStop()
 }
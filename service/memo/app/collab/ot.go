@@ -0,0 +1,241 @@
+// Package collab implements real-time collaborative editing of memos with
+// operational transformation.
+//
+// Documents are plain text addressed in runes. An Operation walks the whole
+// document from start to end, so every operation spells out what happens to
+// each rune: it is retained, deleted, or has text inserted before it.
+package collab
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrInvalidOperation is returned for operations that are malformed or do not
+// fit the document they are applied to.
+var ErrInvalidOperation = errors.New("invalid operation")
+
+// Component is a single step of an Operation. Exactly one field is set.
+type Component struct {
+	// Retain skips this many runes.
+	Retain int
+	// Insert adds this text at the current position.
+	Insert string
+	// Delete removes this many runes.
+	Delete int
+}
+
+// Operation is a sequence of components covering the whole document.
+type Operation []Component
+
+// Retain appends a retain of n runes, merging it with a preceding retain.
+func (o Operation) Retain(n int) Operation {
+	if n <= 0 {
+		return o
+	}
+	if last := len(o) - 1; last >= 0 && o[last].Retain > 0 {
+		o[last].Retain += n
+		return o
+	}
+	return append(o, Component{Retain: n})
+}
+
+// Insert appends an insert of text. Inserts are kept before deletes at the
+// same position so that equivalent operations have a single form.
+func (o Operation) Insert(text string) Operation {
+	if text == "" {
+		return o
+	}
+	last := len(o) - 1
+	if last >= 0 && o[last].Insert != "" {
+		o[last].Insert += text
+		return o
+	}
+	if last >= 0 && o[last].Delete > 0 {
+		if last > 0 && o[last-1].Insert != "" {
+			o[last-1].Insert += text
+			return o
+		}
+		o = append(o, o[last])
+		o[last] = Component{Insert: text}
+		return o
+	}
+	return append(o, Component{Insert: text})
+}
+
+// Delete appends a delete of n runes, merging it with a preceding delete.
+func (o Operation) Delete(n int) Operation {
+	if n <= 0 {
+		return o
+	}
+	if last := len(o) - 1; last >= 0 && o[last].Delete > 0 {
+		o[last].Delete += n
+		return o
+	}
+	return append(o, Component{Delete: n})
+}
+
+// Validate checks that every component sets exactly one positive field.
+func (o Operation) Validate() error {
+	for i, c := range o {
+		set := 0
+		if c.Retain != 0 {
+			set++
+		}
+		if c.Insert != "" {
+			set++
+		}
+		if c.Delete != 0 {
+			set++
+		}
+		if set != 1 || c.Retain < 0 || c.Delete < 0 {
+			return fmt.Errorf("%w: component %d must set exactly one of retain, insert or delete", ErrInvalidOperation, i)
+		}
+	}
+	return nil
+}
+
+// BaseLen is the length in runes of the documents the operation applies to.
+func (o Operation) BaseLen() int {
+	n := 0
+	for _, c := range o {
+		n += c.Retain + c.Delete
+	}
+	return n
+}
+
+// TargetLen is the length in runes of the document the operation produces.
+func (o Operation) TargetLen() int {
+	n := 0
+	for _, c := range o {
+		n += c.Retain + utf8.RuneCountInString(c.Insert)
+	}
+	return n
+}
+
+// IsNoop reports whether the operation leaves the document unchanged.
+func (o Operation) IsNoop() bool {
+	for _, c := range o {
+		if c.Retain == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Apply applies the operation to doc.
+func (o Operation) Apply(doc string) (string, error) {
+	runes := []rune(doc)
+	if o.BaseLen() != len(runes) {
+		return "", fmt.Errorf("%w: operation covers %d runes but the document has %d", ErrInvalidOperation, o.BaseLen(), len(runes))
+	}
+
+	var b strings.Builder
+	pos := 0
+	for _, c := range o {
+		switch {
+		case c.Retain > 0:
+			b.WriteString(string(runes[pos : pos+c.Retain]))
+			pos += c.Retain
+		case c.Insert != "":
+			b.WriteString(c.Insert)
+		case c.Delete > 0:
+			pos += c.Delete
+		}
+	}
+	return b.String(), nil
+}
+
+// Transform transforms two concurrent operations on the same document.
+// It returns a' and b' such that applying a then b' equals applying b then a'.
+// When both insert at the same position, the text of a comes first.
+func Transform(a, b Operation) (Operation, Operation, error) {
+	if a.BaseLen() != b.BaseLen() {
+		return nil, nil, fmt.Errorf("%w: concurrent operations cover %d and %d runes", ErrInvalidOperation, a.BaseLen(), b.BaseLen())
+	}
+
+	var a1, b1 Operation
+	i, j := 0, 0
+	var ca, cb Component
+	hasA, hasB := false, false
+
+	for {
+		if !hasA && i < len(a) {
+			ca, hasA = a[i], true
+			i++
+		}
+		if !hasB && j < len(b) {
+			cb, hasB = b[j], true
+			j++
+		}
+		if !hasA && !hasB {
+			return a1, b1, nil
+		}
+
+		// Inserts do not consume the base document, so they go first.
+		if hasA && ca.Insert != "" {
+			a1 = a1.Insert(ca.Insert)
+			b1 = b1.Retain(utf8.RuneCountInString(ca.Insert))
+			hasA = false
+			continue
+		}
+		if hasB && cb.Insert != "" {
+			a1 = a1.Retain(utf8.RuneCountInString(cb.Insert))
+			b1 = b1.Insert(cb.Insert)
+			hasB = false
+			continue
+		}
+
+		// Equal base lengths guarantee both sides run out together.
+		lenA, lenB := ca.Retain+ca.Delete, cb.Retain+cb.Delete
+		n := min(lenA, lenB)
+		switch {
+		case ca.Retain > 0 && cb.Retain > 0:
+			a1 = a1.Retain(n)
+			b1 = b1.Retain(n)
+		case ca.Delete > 0 && cb.Retain > 0:
+			a1 = a1.Delete(n)
+		case ca.Retain > 0 && cb.Delete > 0:
+			b1 = b1.Delete(n)
+		case ca.Delete > 0 && cb.Delete > 0:
+			// Both deleted the same runes; nothing is left to do.
+		}
+
+		ca, hasA = shrink(ca, n)
+		cb, hasB = shrink(cb, n)
+	}
+}
+
+// shrink removes n runes from a retain or delete component and reports
+// whether anything is left of it.
+func shrink(c Component, n int) (Component, bool) {
+	if c.Retain > 0 {
+		c.Retain -= n
+		return c, c.Retain > 0
+	}
+	c.Delete -= n
+	return c, c.Delete > 0
+}
+
+// TransformIndex moves a rune index in the base document of o to the same
+// place in the document o produces. Text inserted at the index moves it right.
+func TransformIndex(index int, o Operation) int {
+	pos, result := 0, index
+	for _, c := range o {
+		if pos > index {
+			break
+		}
+		switch {
+		case c.Retain > 0:
+			pos += c.Retain
+		case c.Insert != "":
+			result += utf8.RuneCountInString(c.Insert)
+		case c.Delete > 0:
+			result -= min(c.Delete, index-pos)
+			pos += c.Delete
+		}
+	}
+	return result
+}
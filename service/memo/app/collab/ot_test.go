@@ -0,0 +1,119 @@
+package collab
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestApply(t *testing.T) {
+	op := Operation{}.Retain(6).Delete(5).Insert("Gopher").Retain(1)
+
+	got, err := op.Apply("hello world!")
+	if err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+	if got != "hello Gopher!" {
+		t.Errorf("Expected %q, got %q", "hello Gopher!", got)
+	}
+
+	if _, err := op.Apply("too short"); err == nil {
+		t.Error("Expected an error for a document of the wrong length")
+	}
+}
+
+func TestBuilderNormalizesInsertBeforeDelete(t *testing.T) {
+	got := Operation{}.Retain(1).Delete(2).Insert("x").Insert("y")
+	want := Operation{{Retain: 1}, {Insert: "xy"}, {Delete: 2}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestTransform(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		a    Operation
+		b    Operation
+		want string
+	}{
+		{
+			name: "inserts at different positions",
+			doc:  "abc",
+			a:    Operation{}.Insert("X").Retain(3),
+			b:    Operation{}.Retain(3).Insert("Y"),
+			want: "XabcY",
+		},
+		{
+			name: "inserts at the same position put a first",
+			doc:  "abc",
+			a:    Operation{}.Retain(1).Insert("A").Retain(2),
+			b:    Operation{}.Retain(1).Insert("B").Retain(2),
+			want: "aABbc",
+		},
+		{
+			name: "overlapping deletes",
+			doc:  "abcdef",
+			a:    Operation{}.Retain(1).Delete(3).Retain(2),
+			b:    Operation{}.Retain(2).Delete(3).Retain(1),
+			want: "af",
+		},
+		{
+			name: "insert inside a deleted range",
+			doc:  "abcdef",
+			a:    Operation{}.Retain(3).Insert("X").Retain(3),
+			b:    Operation{}.Retain(1).Delete(4).Retain(1),
+			want: "aXf",
+		},
+		{
+			name: "multibyte runes",
+			doc:  "メモ帳",
+			a:    Operation{}.Retain(2).Insert("の").Retain(1),
+			b:    Operation{}.Delete(1).Retain(2),
+			want: "モの帳",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a1, b1, err := Transform(tt.a, tt.b)
+			if err != nil {
+				t.Fatalf("Transform failed: %v", err)
+			}
+
+			ab := mustApply(t, mustApply(t, tt.doc, tt.a), b1)
+			ba := mustApply(t, mustApply(t, tt.doc, tt.b), a1)
+			if ab != ba {
+				t.Fatalf("Documents diverged: %q and %q", ab, ba)
+			}
+			if ab != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, ab)
+			}
+		})
+	}
+}
+
+func TestTransformRejectsMismatchedOperations(t *testing.T) {
+	if _, _, err := Transform(Operation{}.Retain(2), Operation{}.Retain(3)); err == nil {
+		t.Error("Expected an error for operations on different documents")
+	}
+}
+
+func TestTransformIndex(t *testing.T) {
+	op := Operation{}.Retain(2).Insert("xy").Retain(2).Delete(3).Retain(1)
+
+	for index, want := range map[int]int{0: 0, 2: 4, 3: 5, 5: 6, 7: 6, 8: 7} {
+		if got := TransformIndex(index, op); got != want {
+			t.Errorf("TransformIndex(%d): expected %d, got %d", index, want, got)
+		}
+	}
+}
+
+func mustApply(t *testing.T, doc string, op Operation) string {
+	t.Helper()
+	got, err := op.Apply(doc)
+	if err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+	return got
+}
@@ -0,0 +1,392 @@
+package collab
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Sessions keep this many operations to transform late operations against.
+// Clients further behind than that have to join again.
+const maxHistory = 1000
+
+// eventBuffer is the number of events queued for a participant. Operations
+// cannot be skipped, so a participant that falls this far behind is dropped.
+const eventBuffer = 256
+
+var (
+	// ErrStaleRevision is returned for operations based on a revision the
+	// session no longer remembers, or has not reached yet.
+	ErrStaleRevision = errors.New("revision is out of range")
+	// ErrDisconnected is returned for participants that have left the session.
+	ErrDisconnected = errors.New("participant is not connected")
+	// ErrTooLarge is returned for operations that would grow the document
+	// past the limit of its Store.
+	ErrTooLarge = errors.New("document is too large")
+)
+
+// Store loads and saves the document of a session.
+type Store interface {
+	Load() (string, error)
+	Save(content string) error
+}
+
+// SizeLimiter is implemented by stores that bound the size of the document.
+type SizeLimiter interface {
+	// MaxBytes is the largest document in bytes, or 0 for no limit.
+	MaxBytes() int64
+}
+
+// Cursor is a caret or selection in runes. SelectionEnd equals Position
+// when nothing is selected.
+type Cursor struct {
+	Position     int
+	SelectionEnd int
+}
+
+// EventKind tells what an Event carries.
+type EventKind int
+
+const (
+	// EventSnapshot is the first event of a participant: the document and who is editing it.
+	EventSnapshot EventKind = iota
+	// EventOperation is an operation applied to the document, including the
+	// participant's own operations as acknowledgements.
+	EventOperation
+	// EventCursor is a cursor move of another participant.
+	EventCursor
+	// EventPresence is another participant joining or leaving.
+	EventPresence
+)
+
+// Event is sent to participants. Revision is the document revision after the event.
+type Event struct {
+	Kind      EventKind
+	ClientID  string
+	Name      string
+	Revision  int
+	Content   string
+	Operation Operation
+	Cursor    Cursor
+	Online    bool
+	Peers     []Peer
+}
+
+// Peer describes another participant in a snapshot.
+type Peer struct {
+	ClientID string
+	Name     string
+	Cursor   Cursor
+}
+
+// Participant is a client connected to a session.
+type Participant struct {
+	ID     string
+	Name   string
+	cursor Cursor
+	events chan Event
+	closed bool
+}
+
+// Events returns the events for the participant. The channel is closed when
+// the participant leaves or is dropped for falling behind.
+func (p *Participant) Events() <-chan Event {
+	return p.events
+}
+
+// Hub holds the editing sessions of all memos.
+type Hub struct {
+	persistInterval time.Duration
+
+	mu       sync.Mutex
+	sessions map[string]*Session
+}
+
+// NewHub creates a Hub whose sessions save their document every persistInterval.
+func NewHub(persistInterval time.Duration) *Hub {
+	return &Hub{
+		persistInterval: persistInterval,
+		sessions:        make(map[string]*Session),
+	}
+}
+
+// Session is the shared document of one memo.
+type Session struct {
+	key      string
+	store    Store
+	maxBytes int64
+
+	mu           sync.Mutex
+	doc          string
+	base         int // revision before history[0]
+	history      []Operation
+	participants map[string]*Participant
+	dirty        bool
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// Join adds a participant to the session of key, starting the session with
+// the document from store if nobody is editing it yet.
+func (h *Hub) Join(key, name string, store Store) (*Session, *Participant, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	s, ok := h.sessions[key]
+	if !ok {
+		doc, err := store.Load()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load document: %w", err)
+		}
+		s = &Session{
+			key:          key,
+			store:        store,
+			doc:          doc,
+			participants: make(map[string]*Participant),
+			stop:         make(chan struct{}),
+			done:         make(chan struct{}),
+		}
+		if limiter, ok := store.(SizeLimiter); ok {
+			s.maxBytes = limiter.MaxBytes()
+		}
+		h.sessions[key] = s
+		go s.persist(h.persistInterval)
+	}
+
+	p := &Participant{
+		ID:     uuid.New().String(),
+		Name:   name,
+		events: make(chan Event, eventBuffer),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	peers := make([]Peer, 0, len(s.participants))
+	for _, other := range s.participants {
+		peers = append(peers, Peer{ClientID: other.ID, Name: other.Name, Cursor: other.cursor})
+	}
+	s.participants[p.ID] = p
+	p.events <- Event{
+		Kind:     EventSnapshot,
+		ClientID: p.ID,
+		Name:     p.Name,
+		Revision: s.revision(),
+		Content:  s.doc,
+		Peers:    peers,
+	}
+	s.broadcast(p.ID, Event{Kind: EventPresence, ClientID: p.ID, Name: p.Name, Revision: s.revision(), Online: true})
+
+	return s, p, nil
+}
+
+// Leave removes a participant. The last participant to leave ends the
+// session, which saves the document one last time.
+func (h *Hub) Leave(s *Session, p *Participant) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	s.mu.Lock()
+	if _, ok := s.participants[p.ID]; ok {
+		s.remove(p)
+		s.broadcast(p.ID, Event{Kind: EventPresence, ClientID: p.ID, Name: p.Name, Revision: s.revision(), Online: false})
+	}
+	empty := len(s.participants) == 0
+	s.mu.Unlock()
+
+	if empty && h.sessions[s.key] == s {
+		delete(h.sessions, s.key)
+		close(s.stop)
+		// Wait with the hub locked so that a new session cannot load the
+		// document before this one has saved it.
+		<-s.done
+	}
+}
+
+// Close ends every session and waits for their documents to be saved.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	sessions := make([]*Session, 0, len(h.sessions))
+	for key, s := range h.sessions {
+		sessions = append(sessions, s)
+		delete(h.sessions, key)
+
+		s.mu.Lock()
+		for _, p := range s.participants {
+			s.remove(p)
+		}
+		s.mu.Unlock()
+		close(s.stop)
+	}
+	h.mu.Unlock()
+
+	for _, s := range sessions {
+		<-s.done
+	}
+}
+
+// Submit applies an operation that p based on revision. The operation is
+// transformed against everything applied since then and sent to every
+// participant, p included, and the new revision is returned.
+func (s *Session) Submit(p *Participant, revision int, op Operation) (int, error) {
+	if err := op.Validate(); err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.participants[p.ID]; !ok {
+		return 0, ErrDisconnected
+	}
+	concurrent, err := s.since(revision)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, other := range concurrent {
+		op, _, err = Transform(op, other)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	doc, err := op.Apply(s.doc)
+	if err != nil {
+		return 0, err
+	}
+	// Edits that shrink the document are allowed even above the limit.
+	if s.maxBytes > 0 && len(doc) > len(s.doc) && int64(len(doc)) > s.maxBytes {
+		return 0, fmt.Errorf("%w: it would be %d bytes, the limit is %d bytes", ErrTooLarge, len(doc), s.maxBytes)
+	}
+	s.doc = doc
+	s.history = append(s.history, op)
+	if len(s.history) > maxHistory {
+		trim := len(s.history) - maxHistory
+		s.history = append([]Operation(nil), s.history[trim:]...)
+		s.base += trim
+	}
+	if !op.IsNoop() {
+		s.dirty = true
+	}
+
+	for _, other := range s.participants {
+		other.cursor = transformCursor(other.cursor, op)
+	}
+
+	event := Event{Kind: EventOperation, ClientID: p.ID, Name: p.Name, Revision: s.revision(), Operation: op}
+	s.broadcast("", event)
+	return event.Revision, nil
+}
+
+// MoveCursor records the cursor of p at revision and shows it to the others.
+func (s *Session) MoveCursor(p *Participant, revision int, cursor Cursor) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.participants[p.ID]; !ok {
+		return ErrDisconnected
+	}
+	concurrent, err := s.since(revision)
+	if err != nil {
+		return err
+	}
+
+	for _, op := range concurrent {
+		cursor = transformCursor(cursor, op)
+	}
+	length := len([]rune(s.doc))
+	cursor.Position = max(0, min(cursor.Position, length))
+	cursor.SelectionEnd = max(0, min(cursor.SelectionEnd, length))
+	p.cursor = cursor
+
+	s.broadcast(p.ID, Event{Kind: EventCursor, ClientID: p.ID, Name: p.Name, Revision: s.revision(), Cursor: cursor})
+	return nil
+}
+
+// revision is the number of operations applied to the document. s.mu must be held.
+func (s *Session) revision() int {
+	return s.base + len(s.history)
+}
+
+// since returns the operations applied after revision. s.mu must be held.
+func (s *Session) since(revision int) ([]Operation, error) {
+	if revision < s.base || revision > s.revision() {
+		return nil, fmt.Errorf("%w: %d is not between %d and %d", ErrStaleRevision, revision, s.base, s.revision())
+	}
+	return s.history[revision-s.base:], nil
+}
+
+// broadcast queues event for every participant except skipID. Participants
+// whose queue is full are dropped. s.mu must be held.
+func (s *Session) broadcast(skipID string, event Event) {
+	for id, p := range s.participants {
+		if id == skipID {
+			continue
+		}
+		select {
+		case p.events <- event:
+		default:
+			log.Printf("dropping participant %s of %s: too far behind", p.ID, s.key)
+			s.remove(p)
+		}
+	}
+}
+
+// remove disconnects a participant. s.mu must be held.
+func (s *Session) remove(p *Participant) {
+	delete(s.participants, p.ID)
+	if !p.closed {
+		p.closed = true
+		close(p.events)
+	}
+}
+
+// persist saves the document every interval while it changes, and once more
+// when the session ends.
+func (s *Session) persist(interval time.Duration) {
+	defer close(s.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.save()
+		case <-s.stop:
+			s.save()
+			return
+		}
+	}
+}
+
+func (s *Session) save() {
+	s.mu.Lock()
+	if !s.dirty {
+		s.mu.Unlock()
+		return
+	}
+	doc := s.doc
+	s.dirty = false
+	s.mu.Unlock()
+
+	if err := s.store.Save(doc); err != nil {
+		log.Printf("failed to save document %s: %v", s.key, err)
+		s.mu.Lock()
+		s.dirty = true
+		s.mu.Unlock()
+	}
+}
+
+func transformCursor(c Cursor, op Operation) Cursor {
+	return Cursor{
+		Position:     TransformIndex(c.Position, op),
+		SelectionEnd: TransformIndex(c.SelectionEnd, op),
+	}
+}
@@ -0,0 +1,109 @@
+package collab
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+type memoryStore struct {
+	content string
+	saved   []string
+}
+
+func (m *memoryStore) Load() (string, error) {
+	return m.content, nil
+}
+
+func (m *memoryStore) Save(content string) error {
+	m.saved = append(m.saved, content)
+	return nil
+}
+
+func TestSessionConcurrentEdits(t *testing.T) {
+	hub := NewHub(time.Hour)
+	store := &memoryStore{content: "agenda"}
+
+	s, alice, err := hub.Join("memo", "alice", store)
+	if err != nil {
+		t.Fatalf("Join failed: %v", err)
+	}
+	_, bob, err := hub.Join("memo", "bob", store)
+	if err != nil {
+		t.Fatalf("Join failed: %v", err)
+	}
+
+	// Both edit revision 0 without seeing each other's change.
+	if _, err := s.Submit(alice, 0, Operation{}.Insert("# ").Retain(6)); err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	if _, err := s.Submit(bob, 0, Operation{}.Retain(6).Insert("\n- item")); err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	if err := s.MoveCursor(bob, 0, Cursor{Position: 3, SelectionEnd: 5}); err != nil {
+		t.Fatalf("MoveCursor failed: %v", err)
+	}
+
+	// Replaying bob's events from his snapshot must give the session's document.
+	doc := ""
+	for i := 0; i < 3; i++ {
+		event := <-bob.Events()
+		switch event.Kind {
+		case EventSnapshot:
+			doc = event.Content
+		case EventOperation:
+			doc = mustApply(t, doc, event.Operation)
+		}
+	}
+	if want := "# agenda\n- item"; doc != want {
+		t.Errorf("Expected %q, got %q", want, doc)
+	}
+
+	// Alice sees bob's selection shifted by her own insert.
+	var cursor Event
+	for event := range alice.Events() {
+		if event.Kind == EventCursor {
+			cursor = event
+			break
+		}
+	}
+	if cursor.ClientID != bob.ID || cursor.Cursor != (Cursor{Position: 5, SelectionEnd: 7}) {
+		t.Errorf("Unexpected cursor event: %+v", cursor)
+	}
+
+	hub.Leave(s, alice)
+	hub.Leave(s, bob)
+	if len(store.saved) != 1 || store.saved[0] != "# agenda\n- item" {
+		t.Errorf("Expected the document to be saved once on leave, got %q", store.saved)
+	}
+}
+
+type limitedStore struct {
+	memoryStore
+	maxBytes int64
+}
+
+func (l *limitedStore) MaxBytes() int64 {
+	return l.maxBytes
+}
+
+func TestSessionMaxBytes(t *testing.T) {
+	hub := NewHub(time.Hour)
+	defer hub.Close()
+	store := &limitedStore{memoryStore: memoryStore{content: "12345"}, maxBytes: 6}
+
+	s, p, err := hub.Join("memo", "alice", store)
+	if err != nil {
+		t.Fatalf("Join failed: %v", err)
+	}
+
+	if _, err := s.Submit(p, 0, Operation{}.Retain(5).Insert("67")); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("Expected ErrTooLarge, got %v", err)
+	}
+	if _, err := s.Submit(p, 0, Operation{}.Retain(5).Insert("6")); err != nil {
+		t.Errorf("Expected an edit up to the limit to succeed, got %v", err)
+	}
+	if _, err := s.Submit(p, 1, Operation{}.Delete(6)); err != nil {
+		t.Errorf("Expected a delete to succeed, got %v", err)
+	}
+}
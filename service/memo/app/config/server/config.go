@@ -4,15 +4,83 @@ import (
 	"fmt"
 	"path/filepath"
 	"runtime"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
 // Config holds the application configuration
 type Config struct {
-	Port       int    `mapstructure:"port" default:"8080"`
-	Env        string `mapstructure:"env" default:"development"`
-	FolderPath string `mapstructure:"folder_path" default:"/tmp/memo"`
+	Port       int        `mapstructure:"port" default:"8080"`
+	Env        string     `mapstructure:"env" default:"development"`
+	FolderPath string     `mapstructure:"folder_path" default:"/tmp/memo"`
+	Encryption Encryption `mapstructure:"encryption"`
+	Auth       Auth       `mapstructure:"auth"`
+	Git        Git        `mapstructure:"git"`
+	Collab     Collab     `mapstructure:"collab"`
+	Snapshot   Snapshot   `mapstructure:"snapshot"`
+	Quota      Quota      `mapstructure:"quota"`
+}
+
+// Encryption holds the at-rest encryption settings for memo files
+type Encryption struct {
+	Enabled    bool   `mapstructure:"enabled" default:"false"`
+	KeyFile    string `mapstructure:"key_file"`
+	Passphrase string `mapstructure:"passphrase"`
+}
+
+// Auth holds the settings for authenticating requests with auth service tokens
+type Auth struct {
+	Enabled   bool   `mapstructure:"enabled" default:"false"`
+	Mode      string `mapstructure:"mode" default:"remote"`
+	Address   string `mapstructure:"address"`
+	JWTSecret string `mapstructure:"jwt_secret"`
+	// AdminUserIDs may call MemoAdminService when authentication is enabled.
+	AdminUserIDs []string `mapstructure:"admin_user_ids"`
+}
+
+// Git holds the settings for versioning the memo folder as a git repository
+type Git struct {
+	Enabled     bool   `mapstructure:"enabled" default:"false"`
+	AuthorName  string `mapstructure:"author_name" default:"Memo Service"`
+	AuthorEmail string `mapstructure:"author_email" default:"memo@localhost"`
+}
+
+// Collab holds the settings for collaborative editing sessions
+type Collab struct {
+	PersistInterval time.Duration `mapstructure:"persist_interval" default:"10s"`
+}
+
+// Snapshot holds the settings for scheduled snapshots of the memo folder
+type Snapshot struct {
+	Enabled     bool              `mapstructure:"enabled" default:"false"`
+	Interval    time.Duration     `mapstructure:"interval" default:"1h"`
+	Destination string            `mapstructure:"destination"`
+	Retention   SnapshotRetention `mapstructure:"retention"`
+}
+
+// SnapshotRetention is how many hourly, daily and weekly snapshots to keep
+type SnapshotRetention struct {
+	Hourly int `mapstructure:"hourly" default:"24"`
+	Daily  int `mapstructure:"daily" default:"7"`
+	Weekly int `mapstructure:"weekly" default:"4"`
+}
+
+// Quota holds the storage limits for memos
+type Quota struct {
+	QuotaLimits `mapstructure:",squash"`
+	// PerUser applies the limits to each authenticated user's memos instead of
+	// the whole memo folder.
+	PerUser bool `mapstructure:"per_user" default:"false"`
+	// Users replaces the limits of individual users by ID when PerUser is set.
+	Users map[string]QuotaLimits `mapstructure:"users"`
+}
+
+// QuotaLimits is a set of storage limits. Zero means unlimited
+type QuotaLimits struct {
+	MaxContentBytes int64 `mapstructure:"max_content_bytes"`
+	MaxMemos        int   `mapstructure:"max_memos"`
+	MaxTotalBytes   int64 `mapstructure:"max_total_bytes"`
 }
 
 // EnvVar は env 配列の1要素を表す構造体だよ！
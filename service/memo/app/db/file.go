@@ -1,12 +1,18 @@
 package db
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
+	"log"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 
 	config "memo/config/server"
 	"memo/db/model"
+	"memo/encryption"
 )
 
 // FileService defines the interface for file-based memo operations.
@@ -15,29 +21,72 @@ type FileService interface {
 	GetFile(id string) (*model.Memo, error)
 	UpdateFile(memo *model.Memo) (*model.Memo, error)
 	ListFiles() ([]*model.Memo, error)
+	DeleteFile(id string) error
+	Namespace(name string) (FileService, error)
+	Templates() TemplateService
+	Exclusive(fn func() error) error
+	CheckIntegrity(opts IntegrityOptions) (*IntegrityReport, error)
+	Usage() (*Usage, error)
+	NotebookService
 }
 
+var (
+	// ErrNotFound is returned when no memo file matches the requested ID.
+	ErrNotFound = errors.New("memo file not found")
+	// ErrUnreadable is returned when a memo file exists but cannot be decrypted.
+	ErrUnreadable = errors.New("memo file is unreadable")
+)
+
 type fileService struct {
 	folderPath string
+	cipher     *encryption.Cipher
+	// lock is shared by every FileService of the folder. Writes hold it for
+	// reading, so that they run concurrently but never during Exclusive.
+	lock *sync.RWMutex
 }
 
 // GetService creates a new FileService.
 func GetService(config *config.Config) (FileService, error) {
+	cipher, err := encryption.New(config.Encryption)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up encryption: %w", err)
+	}
+
 	return &fileService{
 		folderPath: config.FolderPath,
+		cipher:     cipher,
+		lock:       &sync.RWMutex{},
 	}, nil
 }
 
 // CreateFile creates a new file for the given memo.
 func (f *fileService) CreateFile(memo *model.Memo) (*model.Memo, error) {
-	if err := os.MkdirAll(f.folderPath, 0755); err != nil {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	notebook, err := cleanNotebookPath(memo.Notebook)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.checkNotebookDirs(notebook); err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(f.notebookDir(notebook), 0755); err != nil {
 		return nil, fmt.Errorf("failed to create directory: %w", err)
 	}
 
+	memo = &model.Memo{
+		ID:       memo.ID,
+		FileType: memo.FileType,
+		Title:    memo.Title,
+		Content:  memo.Content,
+		Notebook: notebook,
+	}
 	filePath := memo.GetFilePath(f.folderPath)
 	content := generateContent(memo.FileType, memo.Content)
 
-	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+	if err := f.writeFile(filePath, []byte(content)); err != nil {
 		return nil, fmt.Errorf("failed to write file: %w", err)
 	}
 
@@ -46,141 +95,260 @@ func (f *fileService) CreateFile(memo *model.Memo) (*model.Memo, error) {
 		return nil, fmt.Errorf("failed to get file timestamps: %w", err)
 	}
 
-	return &model.Memo{
+	return applyFrontMatter(&model.Memo{
 		ID:        memo.ID,
 		Title:     memo.Title,
 		FileType:  memo.FileType,
 		Content:   content,
+		Notebook:  notebook,
 		CreatedAt: timestamps.CreatedAt,
 		UpdatedAt: timestamps.UpdatedAt,
-	}, nil
+	}), nil
 }
 
 // GetFile retrieves a memo file by its ID.
 func (f *fileService) GetFile(id string) (*model.Memo, error) {
-	dirEntries, err := os.ReadDir(f.folderPath)
+	file, err := f.findFile(id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read directory: %w", err)
+		return nil, err
 	}
 
-	for _, entry := range dirEntries {
-		if !entry.IsDir() && strings.Contains(entry.Name(), id) {
-			fileName := formatFileName(entry.Name())
-			memo := &model.Memo{
-				ID:       id,
-				FileType: fileName.FileType,
-				Title:    fileName.Title,
-			}
-
-			filePath := memo.GetFilePath(f.folderPath)
-			content, err := os.ReadFile(filePath)
-			if err != nil {
-				return nil, fmt.Errorf("failed to read file: %w", err)
-			}
-
-			generatedContent := generateContent(fileName.FileType, string(content))
-			timestamps, err := getFileTimestamps(filePath)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get file timestamps: %w", err)
-			}
+	content, err := f.readFile(file.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
 
-			return &model.Memo{
-				ID:        id,
-				Title:     fileName.Title,
-				FileType:  fileName.FileType,
-				Content:   generatedContent,
-				CreatedAt: timestamps.CreatedAt,
-				UpdatedAt: timestamps.UpdatedAt,
-			}, nil
-		}
+	generatedContent := generateContent(file.name.FileType, string(content))
+	timestamps, err := getFileTimestamps(file.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file timestamps: %w", err)
 	}
 
-	return nil, fmt.Errorf("file with id %s not found", id)
+	return applyFrontMatter(&model.Memo{
+		ID:        id,
+		Title:     file.name.Title,
+		FileType:  file.name.FileType,
+		Content:   generatedContent,
+		Notebook:  file.notebook,
+		CreatedAt: timestamps.CreatedAt,
+		UpdatedAt: timestamps.UpdatedAt,
+	}), nil
 }
 
 // UpdateFile updates the file for the given memo.
-// It currently reuses CreateFile, which overwrites the existing file.
+// A non-empty title that differs from the current one renames the file.
 func (f *fileService) UpdateFile(targetMemo *model.Memo) (*model.Memo, error) {
-	dirEntries, err := os.ReadDir(f.folderPath)
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	file, err := f.findFile(targetMemo.ID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read directory: %w", err)
+		return nil, err
 	}
 
-	for _, entry := range dirEntries {
-		if !entry.IsDir() && strings.Contains(entry.Name(), targetMemo.ID) {
-			fileName := formatFileName(entry.Name())
-			content := generateContent(fileName.FileType, targetMemo.Content)
-
-			if content == "" {
-				return nil, fmt.Errorf("content is empty")
-			}
+	content := generateContent(file.name.FileType, targetMemo.Content)
+	if content == "" {
+		return nil, fmt.Errorf("content is empty")
+	}
 
-			updatedMemo := &model.Memo{
-				ID:       targetMemo.ID,
-				FileType: fileName.FileType,
-				Title:    fileName.Title,
-				Content:  content,
-			}
+	title := file.name.Title
+	if targetMemo.Title != "" {
+		title = targetMemo.Title
+	}
 
-			filePath := updatedMemo.GetFilePath(f.folderPath)
+	updatedMemo := &model.Memo{
+		ID:       targetMemo.ID,
+		FileType: file.name.FileType,
+		Title:    title,
+		Content:  content,
+		Notebook: file.notebook,
+	}
 
-			if err := os.WriteFile(filePath, []byte(updatedMemo.Content), 0644); err != nil {
-				return nil, fmt.Errorf("failed to write file: %w", err)
-			}
+	filePath := updatedMemo.GetFilePath(f.folderPath)
 
-			timestamps, err := getFileTimestamps(filePath)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get file timestamps: %w", err)
-			}
+	if err := f.writeFile(filePath, []byte(updatedMemo.Content)); err != nil {
+		return nil, fmt.Errorf("failed to write file: %w", err)
+	}
 
-			return &model.Memo{
-				ID:        updatedMemo.ID,
-				Title:     fileName.Title,
-				FileType:  fileName.FileType,
-				Content:   content,
-				CreatedAt: timestamps.CreatedAt,
-				UpdatedAt: timestamps.UpdatedAt,
-			}, nil
+	if file.path != filePath {
+		if err := os.Remove(file.path); err != nil {
+			return nil, fmt.Errorf("failed to remove renamed file: %w", err)
 		}
 	}
 
-	return nil, fmt.Errorf("file with id %s not found", targetMemo.ID)
+	timestamps, err := getFileTimestamps(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file timestamps: %w", err)
+	}
+
+	return applyFrontMatter(&model.Memo{
+		ID:        updatedMemo.ID,
+		Title:     title,
+		FileType:  file.name.FileType,
+		Content:   content,
+		Notebook:  file.notebook,
+		CreatedAt: timestamps.CreatedAt,
+		UpdatedAt: timestamps.UpdatedAt,
+	}), nil
 }
 
-// ListFiles lists all memo files in the folder.
+// ListFiles lists all memo files in the folder and its notebooks.
+// Files that cannot be parsed or read are logged and skipped.
 func (f *fileService) ListFiles() ([]*model.Memo, error) {
-	dirEntries, err := os.ReadDir(f.folderPath)
+	files := make([]*model.Memo, 0)
+	err := f.walkFiles(func(file memoFile) error {
+		content, err := f.readFile(file.path)
+		if err != nil {
+			log.Printf("skipping %s: %v", file.path, err)
+			return nil
+		}
+
+		generatedContent := generateContent(file.name.FileType, string(content))
+
+		files = append(files, applyFrontMatter(&model.Memo{
+			ID:       file.name.ID,
+			Title:    file.name.Title,
+			FileType: file.name.FileType,
+			Content:  generatedContent,
+			Notebook: file.notebook,
+		}))
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to read directory: %w", err)
+		return nil, err
 	}
 
-	files := make([]*model.Memo, 0, len(dirEntries))
-	for _, entry := range dirEntries {
-		if !entry.IsDir() {
-			fileName := formatFileName(entry.Name())
+	return files, nil
+}
+
+// DeleteFile removes the memo file with the given ID.
+func (f *fileService) DeleteFile(id string) error {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
 
-			memo := &model.Memo{
-				ID:       fileName.ID,
-				FileType: fileName.FileType,
-				Title:    fileName.Title,
-			}
+	file, err := f.findFile(id)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(file.path); err != nil {
+		return fmt.Errorf("failed to remove file: %w", err)
+	}
+	return nil
+}
 
-			filePath := memo.GetFilePath(f.folderPath)
-			content, err := os.ReadFile(filePath)
-			if err != nil {
-				return nil, fmt.Errorf("failed to read file: %w", err)
+// memoFile is a memo file found on disk.
+type memoFile struct {
+	path     string
+	notebook string
+	name     FileName
+}
+
+// errStopWalk stops walkFiles early without an error.
+var errStopWalk = errors.New("stop walk")
+
+// walkFiles calls fn for every memo file in the folder and its notebooks.
+// Hidden entries, such as the .git directory, and the templates folder are skipped.
+func (f *fileService) walkFiles(fn func(file memoFile) error) error {
+	err := filepath.WalkDir(f.folderPath, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if path == f.folderPath && errors.Is(err, os.ErrNotExist) {
+				return filepath.SkipDir
+			}
+			return fmt.Errorf("failed to read directory: %w", err)
+		}
+		if path == f.folderPath {
+			return nil
+		}
+		if isHidden(entry.Name()) || f.isTemplatesDir(path) {
+			if entry.IsDir() {
+				return filepath.SkipDir
 			}
+			return nil
+		}
+		// Only regular files count, so symlinks cannot expose files outside the folder.
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		// Stray files are skipped here and reported by CheckIntegrity.
+		name, err := formatFileName(entry.Name())
+		if err != nil {
+			return nil
+		}
 
-			generatedContent := generateContent(fileName.FileType, string(content))
+		notebook, err := filepath.Rel(f.folderPath, filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+		if notebook == "." {
+			notebook = ""
+		}
+
+		return fn(memoFile{
+			path:     path,
+			notebook: filepath.ToSlash(notebook),
+			name:     name,
+		})
+	})
+	if errors.Is(err, errStopWalk) {
+		return nil
+	}
+	return err
+}
 
-			files = append(files, &model.Memo{
-				ID:       fileName.ID,
-				Title:    fileName.Title,
-				FileType: fileName.FileType,
-				Content:  generatedContent,
-			})
+// findFile locates the file of the memo with the given ID.
+func (f *fileService) findFile(id string) (memoFile, error) {
+	var found *memoFile
+	err := f.walkFiles(func(file memoFile) error {
+		if file.name.ID == id {
+			found = &file
+			return errStopWalk
 		}
+		return nil
+	})
+	if err != nil {
+		return memoFile{}, err
 	}
+	if found == nil {
+		return memoFile{}, fmt.Errorf("file with id %s: %w", id, ErrNotFound)
+	}
+	return *found, nil
+}
 
-	return files, nil
+func isHidden(name string) bool {
+	return strings.HasPrefix(name, ".")
+}
+
+// isTemplatesDir reports whether path is the templates folder at the top of the folder.
+func (f *fileService) isTemplatesDir(path string) bool {
+	return path == filepath.Join(f.folderPath, TemplatesDir)
+}
+
+// Namespace returns a FileService scoped to the subfolder name of the folder.
+// It is used to give each user their own memos. Like notebooks, a namespace
+// cannot use the templates folder.
+func (f *fileService) Namespace(name string) (FileService, error) {
+	if name == "" || name == "." || name == ".." || strings.HasPrefix(name, ".") ||
+		strings.ContainsAny(name, `/\`) || filepath.Base(name) != name {
+		return nil, fmt.Errorf("invalid namespace: %q", name)
+	}
+	if name == TemplatesDir {
+		return nil, fmt.Errorf("invalid namespace: %q is reserved for templates", name)
+	}
+
+	return &fileService{
+		folderPath: filepath.Join(f.folderPath, name),
+		cipher:     f.cipher,
+		lock:       f.lock,
+	}, nil
+}
+
+// Exclusive runs fn while no memo file of the folder is being written.
+// Writes through any FileService of the folder wait until fn returns.
+func (f *fileService) Exclusive(fn func() error) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return fn()
 }
@@ -0,0 +1,167 @@
+package db
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"memo/db/model"
+
+	"gopkg.in/yaml.v3"
+)
+
+const frontMatterDelimiter = "---"
+
+// parseFrontMatter extracts tags and string properties from the YAML front matter
+// at the top of a Markdown memo. Content without front matter yields empty results.
+func parseFrontMatter(content string) ([]string, map[string]string, error) {
+	block, ok := frontMatterBlock(content)
+	if !ok {
+		return nil, nil, nil
+	}
+
+	var values map[string]interface{}
+	if err := yaml.Unmarshal([]byte(block), &values); err != nil {
+		return nil, nil, fmt.Errorf("invalid front matter: %w", err)
+	}
+
+	var tags []string
+	properties := make(map[string]string)
+	for key, value := range values {
+		if key == "tags" {
+			tags = parseTags(value)
+			continue
+		}
+		if text, ok := propertyString(value); ok {
+			properties[key] = text
+		}
+	}
+
+	if len(properties) == 0 {
+		properties = nil
+	}
+	return tags, properties, nil
+}
+
+// frontMatterBlock returns the YAML between the leading "---" lines.
+func frontMatterBlock(content string) (string, bool) {
+	content = strings.TrimPrefix(content, "\ufeff")
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	if len(lines) < 2 || strings.TrimSpace(lines[0]) != frontMatterDelimiter {
+		return "", false
+	}
+
+	for i := 1; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if line == frontMatterDelimiter || line == "..." {
+			return strings.Join(lines[1:i], "\n"), true
+		}
+	}
+	return "", false
+}
+
+func parseTags(value interface{}) []string {
+	var raw []string
+	switch v := value.(type) {
+	case string:
+		raw = strings.Split(v, ",")
+	case []interface{}:
+		for _, item := range v {
+			if text, ok := propertyString(item); ok {
+				raw = append(raw, text)
+			}
+		}
+	}
+
+	seen := make(map[string]bool)
+	tags := make([]string, 0, len(raw))
+	for _, tag := range raw {
+		tag = normalizeTag(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+// normalizeTag strips the surrounding space and a leading "#" and lowercases the tag,
+// so that "#Go", "go" and "Go" are one tag, as they are for filtering.
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+}
+
+// propertyString formats scalar front matter values and lists of scalars as strings.
+func propertyString(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case nil:
+		return "", false
+	case string:
+		return v, true
+	case time.Time:
+		if v.Hour() == 0 && v.Minute() == 0 && v.Second() == 0 && v.Nanosecond() == 0 {
+			return v.Format(time.DateOnly), true
+		}
+		return v.Format(time.RFC3339), true
+	case bool, int, int64, uint64, float64:
+		return fmt.Sprint(v), true
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			text, ok := propertyString(item)
+			if !ok {
+				return "", false
+			}
+			items = append(items, text)
+		}
+		return strings.Join(items, ", "), true
+	default:
+		return "", false
+	}
+}
+
+// applyFrontMatter fills the memo's tags and properties from its content.
+// Invalid front matter is treated as plain content.
+func applyFrontMatter(memo *model.Memo) *model.Memo {
+	if memo.FileType != model.FileTypeMd {
+		return memo
+	}
+
+	tags, properties, err := parseFrontMatter(memo.Content)
+	if err != nil {
+		return memo
+	}
+	memo.Tags = tags
+	memo.Properties = properties
+	return memo
+}
+
+// CountTags returns how many memos carry each tag, sorted by count and name.
+func CountTags(memos []*model.Memo) []TagCount {
+	counts := make(map[string]int)
+	for _, memo := range memos {
+		for _, tag := range memo.Tags {
+			counts[tag]++
+		}
+	}
+
+	result := make([]TagCount, 0, len(counts))
+	for tag, count := range counts {
+		result = append(result, TagCount{Tag: tag, Count: count})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].Tag < result[j].Tag
+	})
+	return result
+}
+
+// TagCount is the number of memos carrying a tag.
+type TagCount struct {
+	Tag   string
+	Count int
+}
@@ -0,0 +1,90 @@
+package db
+
+import (
+	"reflect"
+	"testing"
+
+	"memo/db/model"
+)
+
+func TestParseFrontMatter(t *testing.T) {
+	tests := []struct {
+		name           string
+		content        string
+		wantTags       []string
+		wantProperties map[string]string
+		wantErr        bool
+	}{
+		{
+			name:    "no front matter",
+			content: "# Plan\n\nbody",
+		},
+		{
+			name:    "unterminated front matter",
+			content: "---\ntags: go\nbody",
+		},
+		{
+			name:     "tag list",
+			content:  "---\ntags: [Go, '#grpc', go, ' ']\n---\nbody",
+			wantTags: []string{"go", "grpc"},
+		},
+		{
+			name:     "comma separated tags",
+			content:  "---\ntags: \"#Go, gRPC,go\"\n---\n",
+			wantTags: []string{"go", "grpc"},
+		},
+		{
+			name:           "properties",
+			content:        "\ufeff---\r\nstatus: draft\r\ndue: 2024-01-02\r\ndone: false\r\nowners: [alice, bob]\r\nempty:\r\n...\r\nbody",
+			wantProperties: map[string]string{"status": "draft", "due": "2024-01-02", "done": "false", "owners": "alice, bob"},
+		},
+		{
+			name:    "malformed yaml",
+			content: "---\ntags: [go\n---\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tags, properties, err := parseFrontMatter(tt.content)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if len(tags) != 0 || len(tt.wantTags) != 0 {
+				if !reflect.DeepEqual(tags, tt.wantTags) {
+					t.Errorf("Expected tags %v, got %v", tt.wantTags, tags)
+				}
+			}
+			if !reflect.DeepEqual(properties, tt.wantProperties) {
+				t.Errorf("Expected properties %v, got %v", tt.wantProperties, properties)
+			}
+		})
+	}
+}
+
+func TestApplyFrontMatterIgnoresMalformedYAML(t *testing.T) {
+	memo := applyFrontMatter(&model.Memo{FileType: model.FileTypeMd, Content: "---\n: [\n---\n"})
+	if memo.Tags != nil || memo.Properties != nil {
+		t.Errorf("Expected malformed front matter to be plain content, got %v, %v", memo.Tags, memo.Properties)
+	}
+
+	memo = applyFrontMatter(&model.Memo{FileType: model.FileTypeJson, Content: "---\ntags: go\n---\n"})
+	if memo.Tags != nil {
+		t.Errorf("Expected JSON memos to have no front matter, got %v", memo.Tags)
+	}
+}
+
+func TestCountTags(t *testing.T) {
+	memos := []*model.Memo{
+		applyFrontMatter(&model.Memo{FileType: model.FileTypeMd, Content: "---\ntags: [\"#Go\", grpc]\n---\n"}),
+		applyFrontMatter(&model.Memo{FileType: model.FileTypeMd, Content: "---\ntags: go\n---\n"}),
+		applyFrontMatter(&model.Memo{FileType: model.FileTypeMd, Content: "---\ntags: [Go, api]\n---\n"}),
+		{FileType: model.FileTypeMd, Content: "no tags"},
+	}
+
+	want := []TagCount{{Tag: "go", Count: 3}, {Tag: "api", Count: 1}, {Tag: "grpc", Count: 1}}
+	if got := CountTags(memos); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
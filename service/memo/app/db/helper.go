@@ -2,8 +2,10 @@ package db
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"memo/db/model"
+	"memo/encryption"
 	"os"
 	"path/filepath"
 	"strings"
@@ -34,26 +36,79 @@ func getFileTimestamps(filePath string) (FileTimestamps, error) {
 	}, nil
 }
 
+// readFile reads a memo file, decrypting it when it is encrypted.
+// Plaintext files are returned as is so that existing folders keep working.
+func (f *fileService) readFile(filePath string) ([]byte, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if !encryption.IsEncrypted(data) {
+		return data, nil
+	}
+	if f.cipher == nil {
+		return nil, fmt.Errorf("%w: %s is encrypted but encryption is not configured", ErrUnreadable, filepath.Base(filePath))
+	}
+
+	plaintext, err := f.cipher.Decrypt(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrUnreadable, filepath.Base(filePath), err)
+	}
+	return plaintext, nil
+}
+
+// writeFile writes a memo file, encrypting it when encryption is enabled.
+func (f *fileService) writeFile(filePath string, data []byte) error {
+	if f.cipher == nil {
+		return os.WriteFile(filePath, data, 0644)
+	}
+
+	encrypted, err := f.cipher.Encrypt(data)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt file: %w", err)
+	}
+	return os.WriteFile(filePath, encrypted, 0600)
+}
+
 type FileName struct {
 	ID       string
 	FileType model.FileType
 	Title    string
 }
 
-func formatFileName(fileName string) FileName {
+var (
+	// errMalformedName is returned for file names that are not "<title>_<id>.<ext>".
+	errMalformedName = errors.New("malformed memo file name")
+	// errUnsupportedType is returned for files whose extension is not a memo file type.
+	errUnsupportedType = errors.New("unsupported memo file type")
+)
+
+func formatFileName(fileName string) (FileName, error) {
 	ext := filepath.Ext(fileName)
 	fileType := model.FileType(strings.TrimPrefix(ext, "."))
 
 	fileNameWithoutExt := strings.TrimSuffix(fileName, ext)
 
-	title := strings.Split(fileNameWithoutExt, "_")[0]
-	id := strings.Split(fileNameWithoutExt, "_")[1]
+	parts := strings.Split(fileNameWithoutExt, "_")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return FileName{}, fmt.Errorf("%w: %s", errMalformedName, fileName)
+	}
+	if !fileType.IsValid() {
+		return FileName{}, fmt.Errorf("%w: %s", errUnsupportedType, fileName)
+	}
 
 	return FileName{
-		ID:       id,
+		ID:       parts[1],
 		FileType: fileType,
-		Title:    title,
-	}
+		Title:    parts[0],
+	}, nil
+}
+
+// IsMemoFileName reports whether name is a memo file name, "<title>_<id>.<ext>".
+func IsMemoFileName(name string) bool {
+	_, err := formatFileName(name)
+	return err == nil
 }
 
 func generateContent(fileType model.FileType, content string) string {
@@ -72,5 +127,9 @@ func checkJsonContent(content string) string {
 		return content
 	}
 
-	return jsonContent["content"].(string)
+	text, ok := jsonContent["content"].(string)
+	if !ok {
+		return content
+	}
+	return text
 }
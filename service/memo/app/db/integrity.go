@@ -0,0 +1,273 @@
+package db
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"memo/db/model"
+
+	"github.com/google/uuid"
+)
+
+// QuarantineDir is the hidden directory, relative to the memo folder, that
+// holds files moved aside by CheckIntegrity.
+const QuarantineDir = ".quarantine"
+
+// IssueKind classifies a problem found by CheckIntegrity.
+type IssueKind string
+
+const (
+	IssueMalformedName  IssueKind = "malformed_name"
+	IssueDuplicateID    IssueKind = "duplicate_id"
+	IssueUnreadableJSON IssueKind = "unreadable_json"
+	IssueUnreadableFile IssueKind = "unreadable_file"
+	IssueOrphanedFile   IssueKind = "orphaned_file"
+)
+
+// IssueAction is what CheckIntegrity did about an issue.
+type IssueAction string
+
+const (
+	ActionNone        IssueAction = "none"
+	ActionRepaired    IssueAction = "repaired"
+	ActionQuarantined IssueAction = "quarantined"
+	ActionFailed      IssueAction = "failed"
+)
+
+// IntegrityOptions controls what CheckIntegrity does about the issues it finds.
+// Repairable issues are repaired first; the rest are quarantined if requested.
+type IntegrityOptions struct {
+	Repair     bool
+	Quarantine bool
+}
+
+// Issue is a single problem in the memo folder.
+type Issue struct {
+	Kind   IssueKind
+	Path   string
+	ID     string
+	Detail string
+	Action IssueAction
+}
+
+// IntegrityReport is the result of CheckIntegrity.
+type IntegrityReport struct {
+	ScannedFiles int
+	Issues       []Issue
+}
+
+// CheckIntegrity scans the folder, including notebooks, for files that the
+// FileService cannot serve and optionally repairs or quarantines them.
+func (f *fileService) CheckIntegrity(opts IntegrityOptions) (*IntegrityReport, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	report := &IntegrityReport{Issues: make([]Issue, 0)}
+	quarantine := filepath.Join(f.folderPath, QuarantineDir, time.Now().UTC().Format("20060102T150405Z"))
+
+	// Collect the files first so that repairs do not disturb the walk.
+	var paths []string
+	err := filepath.WalkDir(f.folderPath, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if path == f.folderPath && errors.Is(err, os.ErrNotExist) {
+				return filepath.SkipDir
+			}
+			return fmt.Errorf("failed to read directory: %w", err)
+		}
+		if path == f.folderPath {
+			return nil
+		}
+		if isHidden(entry.Name()) {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		paths = append(paths, path)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	seen := make(map[string]string)
+	for _, path := range paths {
+		report.ScannedFiles++
+		issue, ok := f.checkFile(path, seen)
+		if !ok {
+			continue
+		}
+
+		issue.Action = ActionNone
+		if opts.Repair {
+			if repaired, err := f.repairFile(path, &issue); err != nil {
+				issue.Action = ActionFailed
+				issue.Detail += fmt.Sprintf(" (repair failed: %v)", err)
+			} else if repaired {
+				issue.Action = ActionRepaired
+			}
+		}
+		if issue.Action == ActionNone && opts.Quarantine {
+			if err := f.quarantineFile(path, quarantine); err != nil {
+				issue.Action = ActionFailed
+				issue.Detail += fmt.Sprintf(" (quarantine failed: %v)", err)
+			} else {
+				issue.Action = ActionQuarantined
+			}
+		}
+
+		issue.Path = f.relativePath(path)
+		report.Issues = append(report.Issues, issue)
+	}
+
+	return report, nil
+}
+
+// checkFile inspects a single file. seen maps memo IDs to the first path using them.
+func (f *fileService) checkFile(path string, seen map[string]string) (Issue, bool) {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return Issue{Kind: IssueUnreadableFile, Detail: err.Error()}, true
+	}
+	if !info.Mode().IsRegular() {
+		return Issue{Kind: IssueOrphanedFile, Detail: "not a regular file"}, true
+	}
+
+	name, err := formatFileName(filepath.Base(path))
+	switch {
+	case errors.Is(err, errUnsupportedType):
+		return Issue{Kind: IssueOrphanedFile, Detail: err.Error()}, true
+	case err != nil:
+		return Issue{Kind: IssueMalformedName, Detail: err.Error()}, true
+	}
+
+	if first, ok := seen[name.ID]; ok {
+		return Issue{
+			Kind:   IssueDuplicateID,
+			ID:     name.ID,
+			Detail: fmt.Sprintf("ID is already used by %s", f.relativePath(first)),
+		}, true
+	}
+	seen[name.ID] = path
+
+	content, err := f.readFile(path)
+	if err != nil {
+		return Issue{Kind: IssueUnreadableFile, ID: name.ID, Detail: err.Error()}, true
+	}
+
+	if name.FileType == model.FileTypeJson {
+		if detail, ok := checkJSONFile(content); !ok {
+			return Issue{Kind: IssueUnreadableJSON, ID: name.ID, Detail: detail}, true
+		}
+	}
+
+	return Issue{}, false
+}
+
+// checkJSONFile reports JSON memo files that look like JSON but cannot be served.
+// JSON memos are stored as their extracted content, so plain text is fine.
+func checkJSONFile(content []byte) (string, bool) {
+	trimmed := strings.TrimSpace(string(content))
+	if !strings.HasPrefix(trimmed, "{") {
+		return "", true
+	}
+
+	var values map[string]interface{}
+	if err := json.Unmarshal([]byte(trimmed), &values); err != nil {
+		return fmt.Sprintf("invalid JSON: %v", err), false
+	}
+	if value, ok := values["content"]; ok {
+		if _, isString := value.(string); !isString {
+			return "\"content\" is not a string", false
+		}
+	}
+	return "", true
+}
+
+// repairFile fixes an issue in place when possible. It reports whether it did.
+func (f *fileService) repairFile(path string, issue *Issue) (bool, error) {
+	dir := filepath.Dir(path)
+	base := filepath.Base(path)
+	ext := filepath.Ext(base)
+
+	switch issue.Kind {
+	case IssueMalformedName:
+		if !model.FileType(strings.TrimPrefix(ext, ".")).IsValid() {
+			return false, nil
+		}
+		// Keep the title readable and give the memo a fresh ID.
+		title := strings.NewReplacer("_", "-").Replace(strings.TrimSuffix(base, ext))
+		if title == "" {
+			title = "untitled"
+		}
+		newID := uuid.New().String()
+		if err := os.Rename(path, filepath.Join(dir, fmt.Sprintf("%s_%s%s", title, newID, ext))); err != nil {
+			return false, err
+		}
+		issue.ID = newID
+		return true, nil
+
+	case IssueDuplicateID:
+		name, err := formatFileName(base)
+		if err != nil {
+			return false, err
+		}
+		newID := uuid.New().String()
+		if err := os.Rename(path, filepath.Join(dir, fmt.Sprintf("%s_%s%s", name.Title, newID, ext))); err != nil {
+			return false, err
+		}
+		issue.Detail += fmt.Sprintf("; reassigned to %s", newID)
+		return true, nil
+
+	case IssueUnreadableJSON:
+		content, err := f.readFile(path)
+		if err != nil {
+			return false, err
+		}
+		var values map[string]interface{}
+		if err := json.Unmarshal(content, &values); err != nil {
+			// Nothing sensible can be recovered from broken JSON.
+			return false, nil
+		}
+		// Store the non-string content as its JSON text, like other JSON memos.
+		text, err := json.Marshal(values["content"])
+		if err != nil {
+			return false, err
+		}
+		if err := f.writeFile(path, text); err != nil {
+			return false, err
+		}
+		return true, nil
+
+	default:
+		return false, nil
+	}
+}
+
+// quarantineFile moves a file into the quarantine directory, keeping its relative path.
+func (f *fileService) quarantineFile(path, quarantine string) error {
+	target := filepath.Join(quarantine, filepath.FromSlash(f.relativePath(path)))
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+	return os.Rename(path, target)
+}
+
+func (f *fileService) relativePath(path string) string {
+	rel, err := filepath.Rel(f.folderPath, path)
+	if err != nil {
+		return path
+	}
+	return filepath.ToSlash(rel)
+}
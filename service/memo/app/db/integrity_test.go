@@ -0,0 +1,156 @@
+package db
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// newIntegrityFolder writes a folder with one file for each kind of issue.
+func newIntegrityFolder(t *testing.T) *fileService {
+	t.Helper()
+	f := &fileService{folderPath: t.TempDir(), cipher: newTestCipher(t, 1), lock: &sync.RWMutex{}}
+	writeTestFile(t, f, "Plan_a.md", "plan")
+	writeTestFile(t, f, "work/Copy_a.md", "copy")
+	writeTestFile(t, f, "Broken.md", "no ID")
+	writeTestFile(t, f, "Data_j.json", `{"content": 1}`)
+	writeTestFile(t, &fileService{folderPath: f.folderPath, cipher: newTestCipher(t, 2)}, "Secret_s.md", "other key")
+	if err := os.WriteFile(filepath.Join(f.folderPath, "notes_x.pdf"), []byte("stray"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+func TestCheckIntegrity(t *testing.T) {
+	wantKinds := map[string]IssueKind{
+		"Broken.md":      IssueMalformedName,
+		"Data_j.json":    IssueUnreadableJSON,
+		"Secret_s.md":    IssueUnreadableFile,
+		"notes_x.pdf":    IssueOrphanedFile,
+		"work/Copy_a.md": IssueDuplicateID,
+	}
+
+	tests := []struct {
+		name        string
+		opts        IntegrityOptions
+		wantActions map[string]IssueAction
+	}{
+		{
+			name: "report only",
+			wantActions: map[string]IssueAction{
+				"Broken.md": ActionNone, "Data_j.json": ActionNone, "Secret_s.md": ActionNone,
+				"notes_x.pdf": ActionNone, "work/Copy_a.md": ActionNone,
+			},
+		},
+		{
+			name: "repair",
+			opts: IntegrityOptions{Repair: true},
+			wantActions: map[string]IssueAction{
+				"Broken.md": ActionRepaired, "Data_j.json": ActionRepaired, "Secret_s.md": ActionNone,
+				"notes_x.pdf": ActionNone, "work/Copy_a.md": ActionRepaired,
+			},
+		},
+		{
+			name: "repair and quarantine",
+			opts: IntegrityOptions{Repair: true, Quarantine: true},
+			wantActions: map[string]IssueAction{
+				"Broken.md": ActionRepaired, "Data_j.json": ActionRepaired, "Secret_s.md": ActionQuarantined,
+				"notes_x.pdf": ActionQuarantined, "work/Copy_a.md": ActionRepaired,
+			},
+		},
+		{
+			name: "quarantine",
+			opts: IntegrityOptions{Quarantine: true},
+			wantActions: map[string]IssueAction{
+				"Broken.md": ActionQuarantined, "Data_j.json": ActionQuarantined, "Secret_s.md": ActionQuarantined,
+				"notes_x.pdf": ActionQuarantined, "work/Copy_a.md": ActionQuarantined,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newIntegrityFolder(t)
+
+			report, err := f.CheckIntegrity(tt.opts)
+			if err != nil {
+				t.Fatalf("CheckIntegrity failed: %v", err)
+			}
+			if report.ScannedFiles != 6 {
+				t.Errorf("Expected 6 scanned files, got %d", report.ScannedFiles)
+			}
+			if len(report.Issues) != len(wantKinds) {
+				t.Errorf("Expected %d issues, got %+v", len(wantKinds), report.Issues)
+			}
+			for _, issue := range report.Issues {
+				if issue.Kind != wantKinds[issue.Path] {
+					t.Errorf("Expected %s to be %s, got %s", issue.Path, wantKinds[issue.Path], issue.Kind)
+				}
+				if issue.Action != tt.wantActions[issue.Path] {
+					t.Errorf("Expected %s to be %s, got %s (%s)", issue.Path, tt.wantActions[issue.Path], issue.Action, issue.Detail)
+				}
+				assertIssueHandled(t, f, issue)
+			}
+
+			// The first file with an ID is never touched.
+			if content, err := f.readFile(filepath.Join(f.folderPath, "Plan_a.md")); err != nil || string(content) != "plan" {
+				t.Errorf("Expected Plan_a.md to be unchanged, got %q, %v", content, err)
+			}
+
+			// After repairing and quarantining, the folder is clean.
+			if tt.opts.Repair && tt.opts.Quarantine {
+				again, err := f.CheckIntegrity(IntegrityOptions{})
+				if err != nil {
+					t.Fatalf("CheckIntegrity failed: %v", err)
+				}
+				if len(again.Issues) != 0 {
+					t.Errorf("Expected no issues after repair, got %+v", again.Issues)
+				}
+			}
+		})
+	}
+}
+
+// assertIssueHandled checks the file of an issue against the action taken on it.
+func assertIssueHandled(t *testing.T, f *fileService, issue Issue) {
+	t.Helper()
+	path := filepath.Join(f.folderPath, filepath.FromSlash(issue.Path))
+	_, statErr := os.Stat(path)
+
+	switch issue.Action {
+	case ActionNone:
+		if statErr != nil {
+			t.Errorf("Expected %s to be left in place: %v", issue.Path, statErr)
+		}
+	case ActionQuarantined:
+		if !os.IsNotExist(statErr) {
+			t.Errorf("Expected %s to be moved out of the folder", issue.Path)
+		}
+		matches, _ := filepath.Glob(filepath.Join(f.folderPath, QuarantineDir, "*", filepath.FromSlash(issue.Path)))
+		if len(matches) != 1 {
+			t.Errorf("Expected %s in the quarantine, got %v", issue.Path, matches)
+		}
+	case ActionRepaired:
+		switch issue.Kind {
+		case IssueUnreadableJSON:
+			if content, err := f.readFile(path); err != nil || string(content) != "1" {
+				t.Errorf("Expected %s to hold its content as JSON text, got %q, %v", issue.Path, content, err)
+			}
+		default:
+			// Renamed files get a fresh ID and keep their title and content.
+			if !os.IsNotExist(statErr) {
+				t.Errorf("Expected %s to be renamed", issue.Path)
+			}
+			title, _, _ := strings.Cut(strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)), "_")
+			matches, _ := filepath.Glob(filepath.Join(filepath.Dir(path), title+"_*"+filepath.Ext(path)))
+			if len(matches) != 1 {
+				t.Fatalf("Expected %s to be renamed once, got %v", issue.Path, matches)
+			}
+			if name, err := formatFileName(filepath.Base(matches[0])); err != nil || name.ID == "a" {
+				t.Errorf("Expected %s to be renamed with a new ID, got %s", issue.Path, matches[0])
+			}
+		}
+	}
+}
@@ -3,6 +3,7 @@ package model
 import (
 	"fmt"
 	"path/filepath"
+	"strings"
 	"time"
 )
 
@@ -14,15 +15,69 @@ const (
 	FileTypeJson FileType = "json"
 )
 
+// IsValid reports whether t is a supported memo file type.
+func (t FileType) IsValid() bool {
+	switch t {
+	case FileTypeTxt, FileTypeMd, FileTypeJson:
+		return true
+	default:
+		return false
+	}
+}
+
 type Memo struct {
-	ID        string    `json:"id"`
-	FileType  FileType  `json:"file_type"`
-	Title     string    `json:"title"`
-	Content   string    `json:"content"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID         string            `json:"id"`
+	FileType   FileType          `json:"file_type"`
+	Title      string            `json:"title"`
+	Content    string            `json:"content"`
+	Tags       []string          `json:"tags,omitempty"`
+	Properties map[string]string `json:"properties,omitempty"`
+	Notebook   string            `json:"notebook,omitempty"`
+	CreatedAt  time.Time         `json:"created_at"`
+	UpdatedAt  time.Time         `json:"updated_at"`
+}
+
+// HasTags reports whether the memo carries all of the given tags (case-insensitive).
+func (m *Memo) HasTags(tags []string) bool {
+	for _, want := range tags {
+		found := false
+		for _, tag := range m.Tags {
+			if strings.EqualFold(tag, strings.TrimPrefix(strings.TrimSpace(want), "#")) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// HasProperties reports whether the memo has every given property value.
+func (m *Memo) HasProperties(properties map[string]string) bool {
+	for key, want := range properties {
+		if value, ok := m.Properties[key]; !ok || value != want {
+			return false
+		}
+	}
+	return true
+}
+
+// InNotebook reports whether the memo is in the notebook, or in one of its
+// sub-notebooks when recursive is set.
+func (m *Memo) InNotebook(notebook string, recursive bool) bool {
+	notebook = strings.Trim(notebook, "/")
+	if m.Notebook == notebook {
+		return true
+	}
+	if !recursive {
+		return false
+	}
+	return notebook == "" || strings.HasPrefix(m.Notebook, notebook+"/")
 }
 
+// GetFilePath returns the path of the memo file, inside its notebook directory if any.
 func (m *Memo) GetFilePath(folderPath string) string {
-	return filepath.Join(folderPath, fmt.Sprintf("%s_%s.%s", m.Title, m.ID, m.FileType))
+	return filepath.Join(folderPath, filepath.FromSlash(m.Notebook), fmt.Sprintf("%s_%s.%s", m.Title, m.ID, m.FileType))
 }
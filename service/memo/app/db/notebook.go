@@ -0,0 +1,245 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"memo/db/model"
+)
+
+var (
+	// ErrInvalidPath is returned for notebook paths that are malformed or escape the folder.
+	ErrInvalidPath = errors.New("invalid notebook path")
+	// ErrNotebookNotEmpty is returned when deleting a notebook that still has content.
+	ErrNotebookNotEmpty = errors.New("notebook is not empty")
+	// ErrAlreadyExists is returned when creating a notebook that already exists.
+	ErrAlreadyExists = errors.New("notebook already exists")
+)
+
+// Notebook is a subdirectory of the memo folder.
+type Notebook struct {
+	Path      string
+	MemoCount int
+}
+
+// NotebookService defines the notebook operations of a FileService.
+type NotebookService interface {
+	CreateNotebook(notebook string) (*Notebook, error)
+	ListNotebooks() ([]*Notebook, error)
+	MoveFile(id, notebook string) (*model.Memo, error)
+	DeleteNotebook(notebook string, recursive bool) error
+}
+
+// cleanNotebookPath validates a slash-separated notebook path and returns it
+// in canonical form. The empty path is the root of the folder.
+func cleanNotebookPath(notebook string) (string, error) {
+	notebook = strings.Trim(notebook, "/")
+	if notebook == "" {
+		return "", nil
+	}
+	if strings.Contains(notebook, `\`) || strings.ContainsRune(notebook, 0) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidPath, notebook)
+	}
+
+	for i, segment := range strings.Split(notebook, "/") {
+		if segment == "" || segment == "." || segment == ".." || isHidden(segment) {
+			return "", fmt.Errorf("%w: %q", ErrInvalidPath, notebook)
+		}
+		if i == 0 && segment == TemplatesDir {
+			return "", fmt.Errorf("%w: %q is reserved for templates", ErrInvalidPath, notebook)
+		}
+	}
+
+	return path.Clean(notebook), nil
+}
+
+// notebookDir returns the directory of a cleaned notebook path.
+func (f *fileService) notebookDir(notebook string) string {
+	return filepath.Join(f.folderPath, filepath.FromSlash(notebook))
+}
+
+// checkNotebookDirs checks every existing directory on the way to a cleaned
+// notebook path. Symlinks could point outside the folder, so only real
+// directories count. It returns ErrNotFound at the first missing directory.
+func (f *fileService) checkNotebookDirs(notebook string) error {
+	if notebook == "" {
+		return nil
+	}
+
+	dir := f.folderPath
+	for _, segment := range strings.Split(notebook, "/") {
+		dir = filepath.Join(dir, segment)
+		info, err := os.Lstat(dir)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("notebook %q: %w", notebook, ErrNotFound)
+			}
+			return fmt.Errorf("failed to stat notebook: %w", err)
+		}
+		if !info.IsDir() {
+			return fmt.Errorf("%w: %q is not a directory", ErrInvalidPath, notebook)
+		}
+	}
+	return nil
+}
+
+// resolveNotebook validates a notebook path and checks that it exists.
+func (f *fileService) resolveNotebook(notebook string) (string, string, error) {
+	notebook, err := cleanNotebookPath(notebook)
+	if err != nil {
+		return "", "", err
+	}
+	if err := f.checkNotebookDirs(notebook); err != nil {
+		return "", "", err
+	}
+
+	return notebook, f.notebookDir(notebook), nil
+}
+
+// CreateNotebook creates a notebook, including any missing parents.
+func (f *fileService) CreateNotebook(notebook string) (*Notebook, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	notebook, err := cleanNotebookPath(notebook)
+	if err != nil {
+		return nil, err
+	}
+	if notebook == "" {
+		return nil, fmt.Errorf("%w: notebook path is empty", ErrInvalidPath)
+	}
+
+	dir := f.notebookDir(notebook)
+	if _, err := os.Lstat(dir); err == nil {
+		return nil, fmt.Errorf("notebook %q: %w", notebook, ErrAlreadyExists)
+	}
+	if err := f.checkNotebookDirs(notebook); err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create notebook: %w", err)
+	}
+
+	return &Notebook{Path: notebook}, nil
+}
+
+// ListNotebooks lists every notebook with the number of memos directly in it.
+func (f *fileService) ListNotebooks() ([]*Notebook, error) {
+	notebooks := make(map[string]*Notebook)
+
+	err := filepath.WalkDir(f.folderPath, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if p == f.folderPath && errors.Is(err, os.ErrNotExist) {
+				return filepath.SkipDir
+			}
+			return fmt.Errorf("failed to read directory: %w", err)
+		}
+		if p == f.folderPath || !entry.IsDir() {
+			return nil
+		}
+		if isHidden(entry.Name()) || f.isTemplatesDir(p) {
+			return filepath.SkipDir
+		}
+
+		rel, err := filepath.Rel(f.folderPath, p)
+		if err != nil {
+			return err
+		}
+		notebook := filepath.ToSlash(rel)
+		notebooks[notebook] = &Notebook{Path: notebook}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = f.walkFiles(func(file memoFile) error {
+		if notebook, ok := notebooks[file.notebook]; ok {
+			notebook.MemoCount++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*Notebook, 0, len(notebooks))
+	for _, notebook := range notebooks {
+		result = append(result, notebook)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+	return result, nil
+}
+
+// MoveFile moves a memo into another notebook. The empty notebook is the root.
+func (f *fileService) MoveFile(id, notebook string) (*model.Memo, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	notebook, _, err := f.resolveNotebook(notebook)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := f.findFile(id)
+	if err != nil {
+		return nil, err
+	}
+
+	moved := &model.Memo{
+		ID:       file.name.ID,
+		FileType: file.name.FileType,
+		Title:    file.name.Title,
+		Notebook: notebook,
+	}
+	if newPath := moved.GetFilePath(f.folderPath); newPath != file.path {
+		if err := os.Rename(file.path, newPath); err != nil {
+			return nil, fmt.Errorf("failed to move file: %w", err)
+		}
+	}
+
+	return f.GetFile(id)
+}
+
+// DeleteNotebook removes a notebook. Unless recursive is set, the notebook must be empty.
+func (f *fileService) DeleteNotebook(notebook string, recursive bool) error {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	notebook, dir, err := f.resolveNotebook(notebook)
+	if err != nil {
+		return err
+	}
+	if notebook == "" {
+		return fmt.Errorf("%w: the root folder cannot be deleted", ErrInvalidPath)
+	}
+
+	if recursive {
+		if err := os.RemoveAll(dir); err != nil {
+			return fmt.Errorf("failed to delete notebook: %w", err)
+		}
+		return nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("failed to read notebook: %w", err)
+	}
+	if len(entries) > 0 {
+		return fmt.Errorf("notebook %q: %w", notebook, ErrNotebookNotEmpty)
+	}
+
+	if err := os.Remove(dir); err != nil {
+		return fmt.Errorf("failed to delete notebook: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package db
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"memo/db/model"
+)
+
+func TestCleanNotebookPath(t *testing.T) {
+	tests := []struct {
+		notebook string
+		want     string
+		wantErr  bool
+	}{
+		{notebook: "", want: ""},
+		{notebook: "/", want: ""},
+		{notebook: "work", want: "work"},
+		{notebook: "/work/projects/", want: "work/projects"},
+		{notebook: "work/templates", want: "work/templates"},
+		{notebook: "..", wantErr: true},
+		{notebook: "../outside", wantErr: true},
+		{notebook: "work/../../outside", wantErr: true},
+		{notebook: "work/./projects", wantErr: true},
+		{notebook: "work//projects", wantErr: true},
+		{notebook: "//etc/passwd", want: "etc/passwd"},
+		{notebook: `work\projects`, wantErr: true},
+		{notebook: `..\outside`, wantErr: true},
+		{notebook: "C:\\Windows", wantErr: true},
+		{notebook: "work/.git", wantErr: true},
+		{notebook: ".hidden", wantErr: true},
+		{notebook: "templates", wantErr: true},
+		{notebook: "/templates/daily", wantErr: true},
+		{notebook: "work\x00", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.notebook, func(t *testing.T) {
+			got, err := cleanNotebookPath(tt.notebook)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidPath) {
+					t.Errorf("Expected ErrInvalidPath, got %q, %v", got, err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("Expected %q, got %q, %v", tt.want, got, err)
+			}
+		})
+	}
+}
+
+func TestResolveNotebook(t *testing.T) {
+	folder := t.TempDir()
+	outside := t.TempDir()
+	f := &fileService{folderPath: folder}
+
+	if err := os.MkdirAll(filepath.Join(folder, "work", "projects"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(outside, "sub"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(outside, filepath.Join(folder, "link")); err != nil {
+		t.Skipf("symlinks are not supported: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(folder, "Plan_a.md"), []byte("plan"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		notebook string
+		wantDir  string
+		wantErr  error
+	}{
+		{notebook: "", wantDir: folder},
+		{notebook: "work/projects/", wantDir: filepath.Join(folder, "work", "projects")},
+		{notebook: "missing", wantErr: ErrNotFound},
+		{notebook: "work/missing", wantErr: ErrNotFound},
+		{notebook: "../" + filepath.Base(outside), wantErr: ErrInvalidPath},
+		{notebook: "link", wantErr: ErrInvalidPath},
+		{notebook: "link/sub", wantErr: ErrInvalidPath},
+		{notebook: "Plan_a.md", wantErr: ErrInvalidPath},
+		{notebook: "templates", wantErr: ErrInvalidPath},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.notebook, func(t *testing.T) {
+			_, dir, err := f.resolveNotebook(tt.notebook)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Expected %v, got %q, %v", tt.wantErr, dir, err)
+				}
+				return
+			}
+			if err != nil || dir != tt.wantDir {
+				t.Errorf("Expected %q, got %q, %v", tt.wantDir, dir, err)
+			}
+		})
+	}
+
+	// Nothing may be created through a symlinked notebook either.
+	f.lock = &sync.RWMutex{}
+	if _, err := f.CreateFile(&model.Memo{ID: "b", FileType: model.FileTypeMd, Title: "Escaped", Notebook: "link/sub"}); !errors.Is(err, ErrInvalidPath) {
+		t.Errorf("Expected ErrInvalidPath for a memo in a symlinked notebook, got %v", err)
+	}
+	if _, err := f.CreateNotebook("link/new"); !errors.Is(err, ErrInvalidPath) {
+		t.Errorf("Expected ErrInvalidPath for a notebook under a symlink, got %v", err)
+	}
+	if entries, _ := os.ReadDir(filepath.Join(outside, "sub")); len(entries) > 0 {
+		t.Errorf("Expected nothing to be written outside the folder, got %v", entries)
+	}
+}
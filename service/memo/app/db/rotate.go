@@ -0,0 +1,66 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"memo/encryption"
+)
+
+// rotatingSuffix marks the temporary file a memo file is re-encrypted into.
+const rotatingSuffix = ".rotating"
+
+// RotateKey re-encrypts every memo file under folderPath, including templates.
+// Other files, such as stray files that the FileService skips, are left as they are.
+// oldCipher may be nil when the folder is still plaintext, and newCipher may be nil
+// to decrypt the folder. Each file is replaced atomically. It returns the number of
+// rewritten files.
+//
+// RotateKey can be rerun with the same ciphers after a failure: files that the
+// new cipher already reads are left as they are, and temporary files of the
+// interrupted run are removed.
+func RotateKey(folderPath string, oldCipher, newCipher *encryption.Cipher) (int, error) {
+	oldService := &fileService{folderPath: folderPath, cipher: oldCipher}
+	newService := &fileService{folderPath: folderPath, cipher: newCipher}
+
+	rotated := 0
+	rotate := func(file memoFile) error {
+		tmpPath := file.path + rotatingSuffix
+		if err := os.Remove(tmpPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove %s: %w", tmpPath, err)
+		}
+
+		content, err := oldService.readFile(file.path)
+		if errors.Is(err, ErrUnreadable) {
+			if _, newErr := newService.readFile(file.path); newErr == nil {
+				return nil
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", file.path, err)
+		}
+
+		if err := newService.writeFile(tmpPath, content); err != nil {
+			return fmt.Errorf("failed to write %s: %w", tmpPath, err)
+		}
+		if err := os.Rename(tmpPath, file.path); err != nil {
+			os.Remove(tmpPath)
+			return fmt.Errorf("failed to replace %s: %w", file.path, err)
+		}
+
+		rotated++
+		return nil
+	}
+
+	// walkFiles skips the templates folder, so it is walked on its own.
+	templates := &fileService{folderPath: filepath.Join(folderPath, TemplatesDir)}
+	for _, files := range []*fileService{oldService, templates} {
+		if err := files.walkFiles(rotate); err != nil {
+			return rotated, err
+		}
+	}
+
+	return rotated, nil
+}
@@ -0,0 +1,76 @@
+package db
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"memo/encryption"
+)
+
+func TestRotateKey(t *testing.T) {
+	folder := t.TempDir()
+	oldCipher := newTestCipher(t, 1)
+	newCipher := newTestCipher(t, 2)
+
+	old := &fileService{folderPath: folder, cipher: oldCipher}
+	rotated := &fileService{folderPath: folder, cipher: newCipher}
+	writeTestFile(t, old, "Plan_a.md", "plan")
+	writeTestFile(t, old, "work/Notes_b.md", "notes")
+	writeTestFile(t, old, "templates/Daily_c.md", "daily")
+	// Left by an interrupted run that already rotated memo d.
+	writeTestFile(t, rotated, "Done_d.md", "done")
+	writeTestFile(t, rotated, "Plan_a.md.rotating", "partial")
+	// Stray files are not memos and must stay readable as they are.
+	stray := []byte("# README\n")
+	if err := os.WriteFile(filepath.Join(folder, "README.txt"), stray, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := RotateKey(folder, oldCipher, newCipher)
+	if err != nil {
+		t.Fatalf("RotateKey failed: %v", err)
+	}
+	if count != 3 {
+		t.Errorf("Expected 3 rewritten files, got %d", count)
+	}
+
+	for name, want := range map[string]string{
+		"Plan_a.md":            "plan",
+		"work/Notes_b.md":      "notes",
+		"templates/Daily_c.md": "daily",
+		"Done_d.md":            "done",
+	} {
+		got, err := rotated.readFile(filepath.Join(folder, filepath.FromSlash(name)))
+		if err != nil || string(got) != want {
+			t.Errorf("Expected %s to read %q with the new key, got %q, %v", name, want, got, err)
+		}
+	}
+	if data, err := os.ReadFile(filepath.Join(folder, "README.txt")); err != nil || !bytes.Equal(data, stray) {
+		t.Errorf("Expected the stray file to be left alone, got %q, %v", data, err)
+	}
+	if _, err := os.Stat(filepath.Join(folder, "Plan_a.md.rotating")); !os.IsNotExist(err) {
+		t.Errorf("Expected the leftover temporary file to be removed")
+	}
+}
+
+func newTestCipher(t *testing.T, seed byte) *encryption.Cipher {
+	t.Helper()
+	cipher, err := encryption.NewWithKey(bytes.Repeat([]byte{seed}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cipher
+}
+
+func writeTestFile(t *testing.T, f *fileService, name, content string) {
+	t.Helper()
+	path := filepath.Join(f.folderPath, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.writeFile(path, []byte(content)); err != nil {
+		t.Fatal(err)
+	}
+}
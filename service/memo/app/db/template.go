@@ -0,0 +1,63 @@
+package db
+
+import (
+	"path/filepath"
+
+	"memo/db/model"
+)
+
+// TemplatesDir is the folder, directly under a memo folder or namespace, that
+// holds memo templates. It is reserved: it is not a notebook and its files are
+// not memos.
+const TemplatesDir = "templates"
+
+// TemplateService defines the operations on memo templates.
+// Templates are stored like memos, as "<name>_<id>.md" files, so the Title
+// of a returned memo is the template's name.
+type TemplateService interface {
+	CreateTemplate(template *model.Memo) (*model.Memo, error)
+	GetTemplate(id string) (*model.Memo, error)
+	ListTemplates() ([]*model.Memo, error)
+	DeleteTemplate(id string) error
+}
+
+type templateService struct {
+	files *fileService
+}
+
+// Templates returns the TemplateService for the templates folder of f.
+// Each namespace has its own templates, so users cannot change each other's.
+func (f *fileService) Templates() TemplateService {
+	return &templateService{
+		files: &fileService{
+			folderPath: filepath.Join(f.folderPath, TemplatesDir),
+			cipher:     f.cipher,
+			lock:       f.lock,
+		},
+	}
+}
+
+// CreateTemplate stores a new template. Templates are always Markdown.
+func (t *templateService) CreateTemplate(template *model.Memo) (*model.Memo, error) {
+	return t.files.CreateFile(&model.Memo{
+		ID:       template.ID,
+		FileType: model.FileTypeMd,
+		Title:    template.Title,
+		Content:  template.Content,
+	})
+}
+
+// GetTemplate retrieves a template by its ID.
+func (t *templateService) GetTemplate(id string) (*model.Memo, error) {
+	return t.files.GetFile(id)
+}
+
+// ListTemplates lists every template.
+func (t *templateService) ListTemplates() ([]*model.Memo, error) {
+	return t.files.ListFiles()
+}
+
+// DeleteTemplate removes the template with the given ID.
+func (t *templateService) DeleteTemplate(id string) error {
+	return t.files.DeleteFile(id)
+}
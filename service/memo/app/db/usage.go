@@ -0,0 +1,33 @@
+package db
+
+import (
+	"fmt"
+	"os"
+)
+
+// Usage is the storage taken by the memos of a FileService.
+type Usage struct {
+	Memos int
+	// Bytes is the size of the memo files on disk, including front matter
+	// and encryption overhead.
+	Bytes int64
+}
+
+// Usage adds up the memo files. Hidden files, templates and stray files are
+// not memos and are not counted.
+func (f *fileService) Usage() (*Usage, error) {
+	usage := &Usage{}
+	err := f.walkFiles(func(file memoFile) error {
+		info, err := os.Stat(file.path)
+		if err != nil {
+			return fmt.Errorf("failed to stat %s: %w", file.path, err)
+		}
+		usage.Memos++
+		usage.Bytes += info.Size()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
@@ -0,0 +1,286 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	config "memo/config/server"
+)
+
+// Encrypted files start with this magic followed by a format version byte.
+var magic = []byte("MEMOENC\x01")
+
+const (
+	keySize   = 32
+	saltSize  = 16
+	nonceSize = 12
+	tagSize   = 16
+
+	kdfKeyFile    byte = 0
+	kdfPassphrase byte = 1
+
+	pbkdf2Iterations = 600000
+
+	// magic | kdf | salt | key nonce | wrapped data key | data nonce | ciphertext
+	headerSize = 8 + 1 + saltSize + nonceSize + keySize + tagSize + nonceSize
+)
+
+// ErrDecrypt is returned when a file cannot be decrypted with the configured key.
+var ErrDecrypt = errors.New("failed to decrypt memo file")
+
+// Cipher performs envelope encryption of memo files.
+// Each file gets a random data key, which is wrapped with the key encryption key
+// taken from a key file or derived from a passphrase.
+type Cipher struct {
+	kdf        byte
+	key        []byte
+	passphrase string
+	salt       []byte
+
+	mu      sync.Mutex
+	derived map[string][]byte
+}
+
+// New creates a Cipher from the configuration.
+// It returns nil when encryption is disabled.
+func New(cfg config.Encryption) (*Cipher, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	switch {
+	case cfg.KeyFile != "":
+		key, err := LoadKeyFile(cfg.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		return NewWithKey(key)
+	case cfg.Passphrase != "":
+		return NewWithPassphrase(cfg.Passphrase)
+	default:
+		return nil, fmt.Errorf("encryption is enabled but neither key_file nor passphrase is set")
+	}
+}
+
+// NewWithKey creates a Cipher using a raw 32-byte key encryption key.
+func NewWithKey(key []byte) (*Cipher, error) {
+	if len(key) != keySize {
+		return nil, fmt.Errorf("encryption key must be %d bytes, got %d", keySize, len(key))
+	}
+	return &Cipher{
+		kdf:  kdfKeyFile,
+		key:  key,
+		salt: make([]byte, saltSize),
+	}, nil
+}
+
+// NewWithPassphrase creates a Cipher whose key encryption key is derived from a passphrase.
+func NewWithPassphrase(passphrase string) (*Cipher, error) {
+	if passphrase == "" {
+		return nil, fmt.Errorf("passphrase is empty")
+	}
+
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	c := &Cipher{
+		kdf:        kdfPassphrase,
+		passphrase: passphrase,
+		salt:       salt,
+		derived:    make(map[string][]byte),
+	}
+	if _, err := c.keyFor(kdfPassphrase, salt); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// LoadKeyFile reads a key file containing 32 raw bytes or their base64 or hex encoding.
+func LoadKeyFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+	if len(data) == keySize {
+		return data, nil
+	}
+
+	text := strings.TrimSpace(string(data))
+	if key, err := base64.StdEncoding.DecodeString(text); err == nil && len(key) == keySize {
+		return key, nil
+	}
+	if key, err := hex.DecodeString(text); err == nil && len(key) == keySize {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("key file %s does not contain a %d-byte key", path, keySize)
+}
+
+// GenerateKeyFile writes a new random base64-encoded key to path.
+func GenerateKeyFile(path string) ([]byte, error) {
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate key: %w", err)
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(key) + "\n"
+	if err := os.WriteFile(path, []byte(encoded), 0600); err != nil {
+		return nil, fmt.Errorf("failed to write key file: %w", err)
+	}
+	return key, nil
+}
+
+// IsEncrypted reports whether data was produced by Encrypt.
+func IsEncrypted(data []byte) bool {
+	return bytes.HasPrefix(data, magic)
+}
+
+// Encrypt encrypts plaintext with a fresh data key.
+func (c *Cipher) Encrypt(plaintext []byte) ([]byte, error) {
+	kek, err := c.keyFor(c.kdf, c.salt)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+
+	header := make([]byte, 0, headerSize)
+	header = append(header, magic...)
+	header = append(header, c.kdf)
+	header = append(header, c.salt...)
+
+	keyNonce, err := randomNonce()
+	if err != nil {
+		return nil, err
+	}
+	wrapped, err := seal(kek, keyNonce, dataKey, header)
+	if err != nil {
+		return nil, err
+	}
+	header = append(header, keyNonce...)
+	header = append(header, wrapped...)
+
+	dataNonce, err := randomNonce()
+	if err != nil {
+		return nil, err
+	}
+	header = append(header, dataNonce...)
+
+	ciphertext, err := seal(dataKey, dataNonce, plaintext, header)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(header, ciphertext...), nil
+}
+
+// Decrypt reverses Encrypt. It returns ErrDecrypt if the key does not match.
+func (c *Cipher) Decrypt(data []byte) ([]byte, error) {
+	if !IsEncrypted(data) || len(data) < headerSize {
+		return nil, fmt.Errorf("%w: malformed header", ErrDecrypt)
+	}
+
+	offset := len(magic)
+	kdf := data[offset]
+	offset++
+	salt := data[offset : offset+saltSize]
+	offset += saltSize
+	keyHeader := data[:offset]
+	keyNonce := data[offset : offset+nonceSize]
+	offset += nonceSize
+	wrapped := data[offset : offset+keySize+tagSize]
+	offset += keySize + tagSize
+	dataNonce := data[offset : offset+nonceSize]
+	offset += nonceSize
+
+	if kdf != c.kdf {
+		return nil, fmt.Errorf("%w: file was encrypted with a different kind of key", ErrDecrypt)
+	}
+
+	kek, err := c.keyFor(kdf, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey, err := open(kek, keyNonce, wrapped, keyHeader)
+	if err != nil {
+		return nil, fmt.Errorf("%w: wrong key", ErrDecrypt)
+	}
+
+	plaintext, err := open(dataKey, dataNonce, data[offset:], data[:offset])
+	if err != nil {
+		return nil, fmt.Errorf("%w: corrupted content", ErrDecrypt)
+	}
+
+	return plaintext, nil
+}
+
+// keyFor returns the key encryption key for the given KDF and salt.
+// Passphrase-derived keys are cached per salt.
+func (c *Cipher) keyFor(kdf byte, salt []byte) ([]byte, error) {
+	if kdf == kdfKeyFile {
+		return c.key, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if key, ok := c.derived[string(salt)]; ok {
+		return key, nil
+	}
+
+	key, err := pbkdf2.Key(sha256.New, c.passphrase, salt, pbkdf2Iterations, keySize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+	c.derived[string(salt)] = key
+	return key, nil
+}
+
+func randomNonce() ([]byte, error) {
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return nonce, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+func seal(key, nonce, plaintext, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nil, nonce, plaintext, additionalData), nil
+}
+
+func open(key, nonce, ciphertext, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	return gcm.Open(nil, nonce, ciphertext, additionalData)
+}
@@ -0,0 +1,75 @@
+package encryption
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	key := bytes.Repeat([]byte{0x42}, keySize)
+	c, err := NewWithKey(key)
+	if err != nil {
+		t.Fatalf("NewWithKey failed: %v", err)
+	}
+
+	plaintext := []byte("password: hunter2")
+	encrypted, err := c.Encrypt(plaintext)
+	if err != nil {
+		t.Fatalf("Encrypt failed: %v", err)
+	}
+
+	if !IsEncrypted(encrypted) {
+		t.Error("IsEncrypted should be true for encrypted data")
+	}
+	if bytes.Contains(encrypted, plaintext) {
+		t.Error("Encrypted data contains the plaintext")
+	}
+
+	decrypted, err := c.Decrypt(encrypted)
+	if err != nil {
+		t.Fatalf("Decrypt failed: %v", err)
+	}
+	if !bytes.Equal(decrypted, plaintext) {
+		t.Errorf("Expected %q, got %q", plaintext, decrypted)
+	}
+
+	// A different key must not decrypt the file.
+	other, _ := NewWithKey(bytes.Repeat([]byte{0x24}, keySize))
+	if _, err := other.Decrypt(encrypted); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("Expected ErrDecrypt, got %v", err)
+	}
+}
+
+func TestPassphrase(t *testing.T) {
+	c, err := NewWithPassphrase("correct horse battery staple")
+	if err != nil {
+		t.Fatalf("NewWithPassphrase failed: %v", err)
+	}
+
+	encrypted, err := c.Encrypt([]byte("memo"))
+	if err != nil {
+		t.Fatalf("Encrypt failed: %v", err)
+	}
+
+	// The same passphrase works across instances.
+	again, _ := NewWithPassphrase("correct horse battery staple")
+	decrypted, err := again.Decrypt(encrypted)
+	if err != nil {
+		t.Fatalf("Decrypt failed: %v", err)
+	}
+	if string(decrypted) != "memo" {
+		t.Errorf("Expected memo, got %q", decrypted)
+	}
+
+	wrong, _ := NewWithPassphrase("wrong")
+	if _, err := wrong.Decrypt(encrypted); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("Expected ErrDecrypt, got %v", err)
+	}
+}
+
+func TestIsEncryptedPlaintext(t *testing.T) {
+	if IsEncrypted([]byte("# plain memo")) {
+		t.Error("IsEncrypted should be false for plaintext")
+	}
+}
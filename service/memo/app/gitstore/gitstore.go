@@ -0,0 +1,251 @@
+package gitstore
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"memo/encryption"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// ErrNotFound is returned when a commit or a file in a commit does not exist.
+var ErrNotFound = errors.New("not found in history")
+
+// Author identifies who made a change.
+type Author struct {
+	Name  string
+	Email string
+}
+
+// Commit is a single entry of the memo history.
+type Commit struct {
+	Hash    string
+	Message string
+	Author  Author
+	When    time.Time
+}
+
+// Repository records changes of the memo folder in a local git repository.
+type Repository struct {
+	mu   sync.Mutex
+	root string
+	repo *git.Repository
+}
+
+// Open opens the git repository at root, initializing it if needed.
+func Open(root string) (*Repository, error) {
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	repo, err := git.PlainOpen(root)
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		repo, err = git.PlainInit(root, false)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open git repository: %w", err)
+	}
+
+	return &Repository{root: root, repo: repo}, nil
+}
+
+// Commit stages the changed files that match and commits them. A nil match
+// stages every change in the folder. Hidden files and the contents of hidden
+// directories, such as the reminders file, are never staged. Changes to other
+// files are left for later commits. It returns nil when there is nothing to commit.
+func (r *Repository) Commit(message string, author Author, match func(path string) bool) (*Commit, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.commit(message, author, match)
+}
+
+func (r *Repository) commit(message string, author Author, match func(path string) bool) (*Commit, error) {
+	worktree, err := r.repo.Worktree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get worktree: %w", err)
+	}
+
+	status, err := worktree.Status()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status: %w", err)
+	}
+	staged := 0
+	for path, fileStatus := range status {
+		if fileStatus.Worktree == git.Unmodified && fileStatus.Staging == git.Unmodified {
+			continue
+		}
+		if isHidden(path) || (match != nil && !match(path)) {
+			continue
+		}
+		staged++
+
+		if fileStatus.Worktree == git.Deleted {
+			if _, err := worktree.Remove(path); err != nil {
+				return nil, fmt.Errorf("failed to stage removal of %s: %w", path, err)
+			}
+			continue
+		}
+		if _, err := worktree.Add(path); err != nil {
+			return nil, fmt.Errorf("failed to stage %s: %w", path, err)
+		}
+	}
+	if staged == 0 {
+		return nil, nil
+	}
+
+	signature := &object.Signature{Name: author.Name, Email: author.Email, When: time.Now()}
+	hash, err := worktree.Commit(message, &git.CommitOptions{
+		Author:            signature,
+		AllowEmptyCommits: false,
+	})
+	if err != nil {
+		if errors.Is(err, git.ErrEmptyCommit) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to commit: %w", err)
+	}
+
+	return &Commit{
+		Hash:    hash.String(),
+		Message: message,
+		Author:  author,
+		When:    signature.When,
+	}, nil
+}
+
+// isHidden reports whether any element of the slash-separated path starts with a dot.
+func isHidden(path string) bool {
+	for _, name := range strings.Split(path, "/") {
+		if strings.HasPrefix(name, ".") {
+			return true
+		}
+	}
+	return false
+}
+
+// History returns the commits touching files that match, newest first.
+// A limit of zero or less returns every commit.
+func (r *Repository) History(match func(path string) bool, limit int) ([]Commit, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.repo.Head(); errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return []Commit{}, nil
+	}
+
+	iter, err := r.repo.Log(&git.LogOptions{PathFilter: match})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read log: %w", err)
+	}
+	defer iter.Close()
+
+	commits := make([]Commit, 0)
+	for {
+		c, err := iter.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read commit: %w", err)
+		}
+
+		commits = append(commits, Commit{
+			Hash:    c.Hash.String(),
+			Message: c.Message,
+			Author:  Author{Name: c.Author.Name, Email: c.Author.Email},
+			When:    c.Author.When,
+		})
+		if limit > 0 && len(commits) >= limit {
+			break
+		}
+	}
+
+	return commits, nil
+}
+
+// Restore replaces the files that match with their content at the given commit
+// and commits the result.
+func (r *Repository) Restore(hash string, match func(path string) bool, message string, author Author) (*Commit, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	commit, err := r.repo.CommitObject(plumbing.NewHash(hash))
+	if err != nil {
+		if errors.Is(err, plumbing.ErrObjectNotFound) {
+			return nil, fmt.Errorf("commit %s: %w", hash, ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get commit %s: %w", hash, err)
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tree of %s: %w", hash, err)
+	}
+
+	var restored []*object.File
+	err = tree.Files().ForEach(func(f *object.File) error {
+		if match(f.Name) {
+			restored = append(restored, f)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tree of %s: %w", hash, err)
+	}
+	if len(restored) == 0 {
+		return nil, fmt.Errorf("no matching file in commit %s: %w", hash, ErrNotFound)
+	}
+
+	// Remove the current versions first, since the file name may have changed.
+	err = filepath.WalkDir(r.root, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() && entry.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		rel, err := filepath.Rel(r.root, path)
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() && match(filepath.ToSlash(rel)) {
+			return os.Remove(path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove current files: %w", err)
+	}
+
+	for _, f := range restored {
+		content, err := f.Contents()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s at %s: %w", f.Name, hash, err)
+		}
+
+		path := filepath.Join(r.root, filepath.FromSlash(f.Name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return nil, fmt.Errorf("failed to create directory: %w", err)
+		}
+
+		mode := os.FileMode(0644)
+		if encryption.IsEncrypted([]byte(content)) {
+			mode = 0600
+		}
+		if err := os.WriteFile(path, []byte(content), mode); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", f.Name, err)
+		}
+	}
+
+	return r.commit(message, author, match)
+}
@@ -0,0 +1,133 @@
+package gitstore
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCommitHistoryRestore(t *testing.T) {
+	root := t.TempDir()
+	repo, err := Open(root)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+
+	author := Author{Name: "Test User", Email: "test@example.com"}
+	isMemo := func(path string) bool { return strings.Contains(path, "_id-1.") }
+
+	write := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(root, name), []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	write("Title_id-1.md", "first")
+	first, err := repo.Commit("create", author, nil)
+	if err != nil || first == nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+
+	// Rename the memo and change its content.
+	os.Remove(filepath.Join(root, "Title_id-1.md"))
+	write("Renamed_id-1.md", "second")
+	write("Other_id-2.md", "other")
+	if _, err := repo.Commit("update", author, nil); err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+
+	if commit, err := repo.Commit("nothing", author, nil); err != nil || commit != nil {
+		t.Errorf("Expected no commit for a clean worktree, got %v, %v", commit, err)
+	}
+
+	history, err := repo.History(isMemo, 0)
+	if err != nil {
+		t.Fatalf("History failed: %v", err)
+	}
+	if len(history) != 2 || history[1].Hash != first.Hash {
+		t.Fatalf("Unexpected history: %+v", history)
+	}
+	if history[0].Author != author {
+		t.Errorf("Expected author %+v, got %+v", author, history[0].Author)
+	}
+
+	if _, err := repo.Restore(first.Hash, isMemo, "revert", author); err != nil {
+		t.Fatalf("Restore failed: %v", err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(root, "Title_id-1.md"))
+	if err != nil || string(content) != "first" {
+		t.Errorf("Expected restored content %q, got %q (%v)", "first", content, err)
+	}
+	if _, err := os.Stat(filepath.Join(root, "Renamed_id-1.md")); !os.IsNotExist(err) {
+		t.Error("Renamed file should be removed by Restore")
+	}
+	if _, err := os.Stat(filepath.Join(root, "Other_id-2.md")); err != nil {
+		t.Error("Other memos should not be touched by Restore")
+	}
+}
+
+func TestCommitOnlyMatching(t *testing.T) {
+	root := t.TempDir()
+	repo, err := Open(root)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+
+	author := Author{Name: "Test User", Email: "test@example.com"}
+	inAlice := func(path string) bool { return strings.HasPrefix(path, "alice/") }
+
+	for _, name := range []string{"alice/A_id-1.md", "bob/B_id-2.md"} {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		os.MkdirAll(filepath.Dir(path), 0755)
+		if err := os.WriteFile(path, []byte(name), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	if _, err := repo.Commit("alice", author, inAlice); err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+	history, err := repo.History(func(path string) bool { return strings.HasPrefix(path, "bob/") }, 0)
+	if err != nil {
+		t.Fatalf("History failed: %v", err)
+	}
+	if len(history) != 0 {
+		t.Errorf("Expected bob's file to stay uncommitted, got %+v", history)
+	}
+
+	if commit, err := repo.Commit("alice again", author, inAlice); err != nil || commit != nil {
+		t.Errorf("Expected no commit without matching changes, got %v, %v", commit, err)
+	}
+	if commit, err := repo.Commit("bob", author, nil); err != nil || commit == nil {
+		t.Errorf("Expected bob's pending file to be committed, got %v, %v", commit, err)
+	}
+}
+
+func TestCommitSkipsHiddenFiles(t *testing.T) {
+	root := t.TempDir()
+	repo, err := Open(root)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+
+	for _, name := range []string{".reminders.json", ".quarantine/20240101T000000Z/Bad_id-1.md", "Plan_id-2.md"} {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		os.MkdirAll(filepath.Dir(path), 0755)
+		if err := os.WriteFile(path, []byte(name), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	if _, err := repo.Commit("all", Author{Name: "Test User", Email: "test@example.com"}, nil); err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+	history, err := repo.History(func(path string) bool { return strings.HasPrefix(path, ".") }, 0)
+	if err != nil {
+		t.Fatalf("History failed: %v", err)
+	}
+	if len(history) != 0 {
+		t.Errorf("Expected hidden files to stay uncommitted, got %+v", history)
+	}
+}
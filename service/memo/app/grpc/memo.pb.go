@@ -9,6 +9,7 @@ package grpc
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,13 +23,295 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ArchiveFormat int32
+
+const (
+	ArchiveFormat_ARCHIVE_FORMAT_UNSPECIFIED ArchiveFormat = 0
+	ArchiveFormat_ARCHIVE_FORMAT_TAR_GZ      ArchiveFormat = 1
+	ArchiveFormat_ARCHIVE_FORMAT_ZIP         ArchiveFormat = 2
+)
+
+// Enum value maps for ArchiveFormat.
+var (
+	ArchiveFormat_name = map[int32]string{
+		0: "ARCHIVE_FORMAT_UNSPECIFIED",
+		1: "ARCHIVE_FORMAT_TAR_GZ",
+		2: "ARCHIVE_FORMAT_ZIP",
+	}
+	ArchiveFormat_value = map[string]int32{
+		"ARCHIVE_FORMAT_UNSPECIFIED": 0,
+		"ARCHIVE_FORMAT_TAR_GZ":      1,
+		"ARCHIVE_FORMAT_ZIP":         2,
+	}
+)
+
+func (x ArchiveFormat) Enum() *ArchiveFormat {
+	p := new(ArchiveFormat)
+	*p = x
+	return p
+}
+
+func (x ArchiveFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArchiveFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_api_memo_proto_enumTypes[0].Descriptor()
+}
+
+func (ArchiveFormat) Type() protoreflect.EnumType {
+	return &file_proto_api_memo_proto_enumTypes[0]
+}
+
+func (x ArchiveFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArchiveFormat.Descriptor instead.
+func (ArchiveFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{0}
+}
+
+type ConflictPolicy int32
+
+const (
+	ConflictPolicy_CONFLICT_POLICY_UNSPECIFIED ConflictPolicy = 0
+	ConflictPolicy_CONFLICT_POLICY_SKIP        ConflictPolicy = 1
+	// OVERWRITE replaces the title and content of the existing memo, which
+	// keeps its notebook and file type.
+	ConflictPolicy_CONFLICT_POLICY_OVERWRITE ConflictPolicy = 2
+	ConflictPolicy_CONFLICT_POLICY_RENAME    ConflictPolicy = 3
+)
+
+// Enum value maps for ConflictPolicy.
+var (
+	ConflictPolicy_name = map[int32]string{
+		0: "CONFLICT_POLICY_UNSPECIFIED",
+		1: "CONFLICT_POLICY_SKIP",
+		2: "CONFLICT_POLICY_OVERWRITE",
+		3: "CONFLICT_POLICY_RENAME",
+	}
+	ConflictPolicy_value = map[string]int32{
+		"CONFLICT_POLICY_UNSPECIFIED": 0,
+		"CONFLICT_POLICY_SKIP":        1,
+		"CONFLICT_POLICY_OVERWRITE":   2,
+		"CONFLICT_POLICY_RENAME":      3,
+	}
+)
+
+func (x ConflictPolicy) Enum() *ConflictPolicy {
+	p := new(ConflictPolicy)
+	*p = x
+	return p
+}
+
+func (x ConflictPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConflictPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_api_memo_proto_enumTypes[1].Descriptor()
+}
+
+func (ConflictPolicy) Type() protoreflect.EnumType {
+	return &file_proto_api_memo_proto_enumTypes[1]
+}
+
+func (x ConflictPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConflictPolicy.Descriptor instead.
+func (ConflictPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{1}
+}
+
+type ImportStatus int32
+
+const (
+	ImportStatus_IMPORT_STATUS_UNSPECIFIED ImportStatus = 0
+	ImportStatus_IMPORT_STATUS_CREATED     ImportStatus = 1
+	ImportStatus_IMPORT_STATUS_SKIPPED     ImportStatus = 2
+	ImportStatus_IMPORT_STATUS_OVERWRITTEN ImportStatus = 3
+	ImportStatus_IMPORT_STATUS_RENAMED     ImportStatus = 4
+	ImportStatus_IMPORT_STATUS_FAILED      ImportStatus = 5
+)
+
+// Enum value maps for ImportStatus.
+var (
+	ImportStatus_name = map[int32]string{
+		0: "IMPORT_STATUS_UNSPECIFIED",
+		1: "IMPORT_STATUS_CREATED",
+		2: "IMPORT_STATUS_SKIPPED",
+		3: "IMPORT_STATUS_OVERWRITTEN",
+		4: "IMPORT_STATUS_RENAMED",
+		5: "IMPORT_STATUS_FAILED",
+	}
+	ImportStatus_value = map[string]int32{
+		"IMPORT_STATUS_UNSPECIFIED": 0,
+		"IMPORT_STATUS_CREATED":     1,
+		"IMPORT_STATUS_SKIPPED":     2,
+		"IMPORT_STATUS_OVERWRITTEN": 3,
+		"IMPORT_STATUS_RENAMED":     4,
+		"IMPORT_STATUS_FAILED":      5,
+	}
+)
+
+func (x ImportStatus) Enum() *ImportStatus {
+	p := new(ImportStatus)
+	*p = x
+	return p
+}
+
+func (x ImportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_api_memo_proto_enumTypes[2].Descriptor()
+}
+
+func (ImportStatus) Type() protoreflect.EnumType {
+	return &file_proto_api_memo_proto_enumTypes[2]
+}
+
+func (x ImportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportStatus.Descriptor instead.
+func (ImportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{2}
+}
+
+type IntegrityIssueKind int32
+
+const (
+	IntegrityIssueKind_INTEGRITY_ISSUE_KIND_UNSPECIFIED     IntegrityIssueKind = 0
+	IntegrityIssueKind_INTEGRITY_ISSUE_KIND_MALFORMED_NAME  IntegrityIssueKind = 1
+	IntegrityIssueKind_INTEGRITY_ISSUE_KIND_DUPLICATE_ID    IntegrityIssueKind = 2
+	IntegrityIssueKind_INTEGRITY_ISSUE_KIND_UNREADABLE_JSON IntegrityIssueKind = 3
+	IntegrityIssueKind_INTEGRITY_ISSUE_KIND_UNREADABLE_FILE IntegrityIssueKind = 4
+	IntegrityIssueKind_INTEGRITY_ISSUE_KIND_ORPHANED_FILE   IntegrityIssueKind = 5
+)
+
+// Enum value maps for IntegrityIssueKind.
+var (
+	IntegrityIssueKind_name = map[int32]string{
+		0: "INTEGRITY_ISSUE_KIND_UNSPECIFIED",
+		1: "INTEGRITY_ISSUE_KIND_MALFORMED_NAME",
+		2: "INTEGRITY_ISSUE_KIND_DUPLICATE_ID",
+		3: "INTEGRITY_ISSUE_KIND_UNREADABLE_JSON",
+		4: "INTEGRITY_ISSUE_KIND_UNREADABLE_FILE",
+		5: "INTEGRITY_ISSUE_KIND_ORPHANED_FILE",
+	}
+	IntegrityIssueKind_value = map[string]int32{
+		"INTEGRITY_ISSUE_KIND_UNSPECIFIED":     0,
+		"INTEGRITY_ISSUE_KIND_MALFORMED_NAME":  1,
+		"INTEGRITY_ISSUE_KIND_DUPLICATE_ID":    2,
+		"INTEGRITY_ISSUE_KIND_UNREADABLE_JSON": 3,
+		"INTEGRITY_ISSUE_KIND_UNREADABLE_FILE": 4,
+		"INTEGRITY_ISSUE_KIND_ORPHANED_FILE":   5,
+	}
+)
+
+func (x IntegrityIssueKind) Enum() *IntegrityIssueKind {
+	p := new(IntegrityIssueKind)
+	*p = x
+	return p
+}
+
+func (x IntegrityIssueKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IntegrityIssueKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_api_memo_proto_enumTypes[3].Descriptor()
+}
+
+func (IntegrityIssueKind) Type() protoreflect.EnumType {
+	return &file_proto_api_memo_proto_enumTypes[3]
+}
+
+func (x IntegrityIssueKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IntegrityIssueKind.Descriptor instead.
+func (IntegrityIssueKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{3}
+}
+
+type IntegrityAction int32
+
+const (
+	IntegrityAction_INTEGRITY_ACTION_UNSPECIFIED IntegrityAction = 0
+	IntegrityAction_INTEGRITY_ACTION_NONE        IntegrityAction = 1
+	IntegrityAction_INTEGRITY_ACTION_REPAIRED    IntegrityAction = 2
+	IntegrityAction_INTEGRITY_ACTION_QUARANTINED IntegrityAction = 3
+	IntegrityAction_INTEGRITY_ACTION_FAILED      IntegrityAction = 4
+)
+
+// Enum value maps for IntegrityAction.
+var (
+	IntegrityAction_name = map[int32]string{
+		0: "INTEGRITY_ACTION_UNSPECIFIED",
+		1: "INTEGRITY_ACTION_NONE",
+		2: "INTEGRITY_ACTION_REPAIRED",
+		3: "INTEGRITY_ACTION_QUARANTINED",
+		4: "INTEGRITY_ACTION_FAILED",
+	}
+	IntegrityAction_value = map[string]int32{
+		"INTEGRITY_ACTION_UNSPECIFIED": 0,
+		"INTEGRITY_ACTION_NONE":        1,
+		"INTEGRITY_ACTION_REPAIRED":    2,
+		"INTEGRITY_ACTION_QUARANTINED": 3,
+		"INTEGRITY_ACTION_FAILED":      4,
+	}
+)
+
+func (x IntegrityAction) Enum() *IntegrityAction {
+	p := new(IntegrityAction)
+	*p = x
+	return p
+}
+
+func (x IntegrityAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IntegrityAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_api_memo_proto_enumTypes[4].Descriptor()
+}
+
+func (IntegrityAction) Type() protoreflect.EnumType {
+	return &file_proto_api_memo_proto_enumTypes[4]
+}
+
+func (x IntegrityAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IntegrityAction.Descriptor instead.
+func (IntegrityAction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{4}
+}
+
 type Memo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title     string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content   string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// tags and properties come from the YAML front matter of Markdown memos.
+	// Tags are lowercased and have no leading "#".
+	Tags       []string          `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	Properties map[string]string `protobuf:"bytes,7,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Slash-separated notebook path. Empty for memos at the top level.
+	Notebook string `protobuf:"bytes,8,opt,name=notebook,proto3" json:"notebook,omitempty"`
+	// Unset when the memo has no reminder.
+	RemindAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=remind_at,json=remindAt,proto3" json:"remind_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -98,10 +381,45 @@ func (x *Memo) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Memo) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Memo) GetProperties() map[string]string {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *Memo) GetNotebook() string {
+	if x != nil {
+		return x.Notebook
+	}
+	return ""
+}
+
+func (x *Memo) GetRemindAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindAt
+	}
+	return nil
+}
+
 type CreateMemoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Title    string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Content  string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Notebook string                 `protobuf:"bytes,3,opt,name=notebook,proto3" json:"notebook,omitempty"`
+	RemindAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=remind_at,json=remindAt,proto3" json:"remind_at,omitempty"`
+	// Renders the content from a template instead of taking it from content.
+	TemplateId string `protobuf:"bytes,5,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// Values for the template, available as {{.name}}. They override built-ins
+	// such as {{.Date}} and {{.User}}.
+	Variables     map[string]string `protobuf:"bytes,6,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -150,6 +468,34 @@ func (x *CreateMemoRequest) GetContent() string {
 	return ""
 }
 
+func (x *CreateMemoRequest) GetNotebook() string {
+	if x != nil {
+		return x.Notebook
+	}
+	return ""
+}
+
+func (x *CreateMemoRequest) GetRemindAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindAt
+	}
+	return nil
+}
+
+func (x *CreateMemoRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreateMemoRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
 type CreateMemoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Memo          *Memo                  `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
@@ -423,8 +769,11 @@ func (x *GetMultiMemoRequest) GetMemoIds() []string {
 }
 
 type GetMultiMemoResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Memo          *Memo                  `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: always empty. Use memos.
+	Memo *Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The requested memos, in request order.
+	Memos         []*Memo `protobuf:"bytes,2,rep,name=memos,proto3" json:"memos,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -466,10 +815,24 @@ func (x *GetMultiMemoResponse) GetMemo() *Memo {
 	return nil
 }
 
+func (x *GetMultiMemoResponse) GetMemos() []*Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
 type ListMemosRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	// Only memos carrying all of these tags are returned.
+	Tags []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Only memos whose front matter has all of these values are returned.
+	Properties map[string]string `protobuf:"bytes,4,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Only memos in this notebook are returned; recursive includes its sub-notebooks.
+	Notebook      *string `protobuf:"bytes,5,opt,name=notebook,proto3,oneof" json:"notebook,omitempty"`
+	Recursive     bool    `protobuf:"varint,6,opt,name=recursive,proto3" json:"recursive,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -518,6 +881,34 @@ func (x *ListMemosRequest) GetEndTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ListMemosRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListMemosRequest) GetProperties() map[string]string {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *ListMemosRequest) GetNotebook() string {
+	if x != nil && x.Notebook != nil {
+		return *x.Notebook
+	}
+	return ""
+}
+
+func (x *ListMemosRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
 type ListMemosResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Memos         []*Memo                `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
@@ -563,9 +954,15 @@ func (x *ListMemosResponse) GetMemos() []*Memo {
 }
 
 type UpdateMemoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// Renames the memo and rewrites [[title]] links that point to it.
+	Title *string `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	// Sets the reminder. Content may be left empty to change only the reminder.
+	RemindAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=remind_at,json=remindAt,proto3" json:"remind_at,omitempty"`
+	// Removes the reminder.
+	ClearReminder bool `protobuf:"varint,5,opt,name=clear_reminder,json=clearReminder,proto3" json:"clear_reminder,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -614,6 +1011,27 @@ func (x *UpdateMemoRequest) GetContent() string {
 	return ""
 }
 
+func (x *UpdateMemoRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateMemoRequest) GetRemindAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindAt
+	}
+	return nil
+}
+
+func (x *UpdateMemoRequest) GetClearReminder() bool {
+	if x != nil {
+		return x.ClearReminder
+	}
+	return false
+}
+
 type UpdateMemoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Memo          *Memo                  `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
@@ -658,66 +1076,3616 @@ func (x *UpdateMemoResponse) GetMemo() *Memo {
 	return nil
 }
 
-var File_proto_api_memo_proto protoreflect.FileDescriptor
+type ExportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty exports every memo.
+	MemoIds       []string      `protobuf:"bytes,1,rep,name=memo_ids,json=memoIds,proto3" json:"memo_ids,omitempty"`
+	Format        ArchiveFormat `protobuf:"varint,2,opt,name=format,proto3,enum=memo.ArchiveFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_api_memo_proto_rawDesc = "" +
-	"\n" +
-	"\x14proto/api/memo.proto\x12\x04memo\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbc\x01\n" +
-	"\x04Memo\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\x129\n" +
-	"\n" +
-	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"C\n" +
-	"\x11CreateMemoRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\"4\n" +
-	"\x12CreateMemoResponse\x12\x1e\n" +
-	"\x04memo\x18\x01 \x01(\v2\n" +
-	".memo.MemoR\x04memo\"I\n" +
-	"\x17CreateMemoByJsonRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\":\n" +
-	"\x18CreateMemoByJsonResponse\x12\x1e\n" +
-	"\x04memo\x18\x01 \x01(\v2\n" +
-	".memo.MemoR\x04memo\" \n" +
-	"\x0eGetMemoRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
-	"\x0fGetMemoResponse\x12\x1e\n" +
-	"\x04memo\x18\x01 \x01(\v2\n" +
-	".memo.MemoR\x04memo\"0\n" +
-	"\x13GetMultiMemoRequest\x12\x19\n" +
-	"\bmemo_ids\x18\x01 \x03(\tR\amemoIds\"6\n" +
-	"\x14GetMultiMemoResponse\x12\x1e\n" +
-	"\x04memo\x18\x01 \x01(\v2\n" +
-	".memo.MemoR\x04memo\"\xaa\x01\n" +
-	"\x10ListMemosRequest\x12>\n" +
-	"\n" +
-	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tstartTime\x88\x01\x01\x12:\n" +
-	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\aendTime\x88\x01\x01B\r\n" +
-	"\v_start_timeB\v\n" +
-	"\t_end_time\"5\n" +
-	"\x11ListMemosResponse\x12 \n" +
-	"\x05memos\x18\x01 \x03(\v2\n" +
-	".memo.MemoR\x05memos\"=\n" +
-	"\x11UpdateMemoRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\"4\n" +
-	"\x12UpdateMemoResponse\x12\x1e\n" +
-	"\x04memo\x18\x01 \x01(\v2\n" +
-	".memo.MemoR\x04memo2\xa0\x03\n" +
-	"\vMemoService\x12?\n" +
-	"\n" +
-	"CreateMemo\x12\x17.memo.CreateMemoRequest\x1a\x18.memo.CreateMemoResponse\x12Q\n" +
-	"\x10CreateMemoByJson\x12\x1d.memo.CreateMemoByJsonRequest\x1a\x1e.memo.CreateMemoByJsonResponse\x126\n" +
-	"\aGetMemo\x12\x14.memo.GetMemoRequest\x1a\x15.memo.GetMemoResponse\x12F\n" +
-	"\rGetMultiMemos\x12\x19.memo.GetMultiMemoRequest\x1a\x1a.memo.GetMultiMemoResponse\x12<\n" +
-	"\tListMemos\x12\x16.memo.ListMemosRequest\x1a\x17.memo.ListMemosResponse\x12?\n" +
-	"\n" +
-	"UpdateMemo\x12\x17.memo.UpdateMemoRequest\x1a\x18.memo.UpdateMemoResponseB\n" +
-	"Z\bapp/grpcb\x06proto3"
+func (x *ExportRequest) Reset() {
+	*x = ExportRequest{}
+	mi := &file_proto_api_memo_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRequest) ProtoMessage() {}
+
+func (x *ExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRequest.ProtoReflect.Descriptor instead.
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExportRequest) GetMemoIds() []string {
+	if x != nil {
+		return x.MemoIds
+	}
+	return nil
+}
+
+func (x *ExportRequest) GetFormat() ArchiveFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ArchiveFormat_ARCHIVE_FORMAT_UNSPECIFIED
+}
+
+type ArchiveChunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Data  []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// format and conflict_policy are only read from the first chunk of an import.
+	Format         ArchiveFormat  `protobuf:"varint,2,opt,name=format,proto3,enum=memo.ArchiveFormat" json:"format,omitempty"`
+	ConflictPolicy ConflictPolicy `protobuf:"varint,3,opt,name=conflict_policy,json=conflictPolicy,proto3,enum=memo.ConflictPolicy" json:"conflict_policy,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ArchiveChunk) Reset() {
+	*x = ArchiveChunk{}
+	mi := &file_proto_api_memo_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveChunk) ProtoMessage() {}
+
+func (x *ArchiveChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveChunk.ProtoReflect.Descriptor instead.
+func (*ArchiveChunk) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ArchiveChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ArchiveChunk) GetFormat() ArchiveFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ArchiveFormat_ARCHIVE_FORMAT_UNSPECIFIED
+}
+
+func (x *ArchiveChunk) GetConflictPolicy() ConflictPolicy {
+	if x != nil {
+		return x.ConflictPolicy
+	}
+	return ConflictPolicy_CONFLICT_POLICY_UNSPECIFIED
+}
+
+type ImportResult struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title  string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Status ImportStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=memo.ImportStatus" json:"status,omitempty"`
+	// new_id is set when the memo was stored under a different ID.
+	NewId         string `protobuf:"bytes,4,opt,name=new_id,json=newId,proto3" json:"new_id,omitempty"`
+	Error         string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportResult) Reset() {
+	*x = ImportResult{}
+	mi := &file_proto_api_memo_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResult) ProtoMessage() {}
+
+func (x *ImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportResult.ProtoReflect.Descriptor instead.
+func (*ImportResult) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ImportResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportResult) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ImportResult) GetStatus() ImportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportStatus_IMPORT_STATUS_UNSPECIFIED
+}
+
+func (x *ImportResult) GetNewId() string {
+	if x != nil {
+		return x.NewId
+	}
+	return ""
+}
+
+func (x *ImportResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ImportMemosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*ImportResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportMemosResponse) Reset() {
+	*x = ImportMemosResponse{}
+	mi := &file_proto_api_memo_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportMemosResponse) ProtoMessage() {}
+
+func (x *ImportMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportMemosResponse.ProtoReflect.Descriptor instead.
+func (*ImportMemosResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ImportMemosResponse) GetResults() []*ImportResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ListMemoTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoTagsRequest) Reset() {
+	*x = ListMemoTagsRequest{}
+	mi := &file_proto_api_memo_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoTagsRequest) ProtoMessage() {}
+
+func (x *ListMemoTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoTagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{17}
+}
+
+type TagCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagCount) Reset() {
+	*x = TagCount{}
+	mi := &file_proto_api_memo_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagCount) ProtoMessage() {}
+
+func (x *TagCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagCount.ProtoReflect.Descriptor instead.
+func (*TagCount) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TagCount) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListMemoTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*TagCount            `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoTagsResponse) Reset() {
+	*x = ListMemoTagsResponse{}
+	mi := &file_proto_api_memo_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoTagsResponse) ProtoMessage() {}
+
+func (x *ListMemoTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoTagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListMemoTagsResponse) GetTags() []*TagCount {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type MemoRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoRef) Reset() {
+	*x = MemoRef{}
+	mi := &file_proto_api_memo_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoRef) ProtoMessage() {}
+
+func (x *MemoRef) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoRef.ProtoReflect.Descriptor instead.
+func (*MemoRef) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MemoRef) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MemoRef) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type GetMemoLinksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoLinksRequest) Reset() {
+	*x = GetMemoLinksRequest{}
+	mi := &file_proto_api_memo_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoLinksRequest) ProtoMessage() {}
+
+func (x *GetMemoLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoLinksRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoLinksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetMemoLinksRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetMemoLinksResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Outgoing  []*MemoRef             `protobuf:"bytes,1,rep,name=outgoing,proto3" json:"outgoing,omitempty"`
+	Backlinks []*MemoRef             `protobuf:"bytes,2,rep,name=backlinks,proto3" json:"backlinks,omitempty"`
+	// Link targets that match no memo ID or title.
+	Unresolved    []string `protobuf:"bytes,3,rep,name=unresolved,proto3" json:"unresolved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoLinksResponse) Reset() {
+	*x = GetMemoLinksResponse{}
+	mi := &file_proto_api_memo_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoLinksResponse) ProtoMessage() {}
+
+func (x *GetMemoLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoLinksResponse.ProtoReflect.Descriptor instead.
+func (*GetMemoLinksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetMemoLinksResponse) GetOutgoing() []*MemoRef {
+	if x != nil {
+		return x.Outgoing
+	}
+	return nil
+}
+
+func (x *GetMemoLinksResponse) GetBacklinks() []*MemoRef {
+	if x != nil {
+		return x.Backlinks
+	}
+	return nil
+}
+
+func (x *GetMemoLinksResponse) GetUnresolved() []string {
+	if x != nil {
+		return x.Unresolved
+	}
+	return nil
+}
+
+type GetMemoGraphRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoGraphRequest) Reset() {
+	*x = GetMemoGraphRequest{}
+	mi := &file_proto_api_memo_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoGraphRequest) ProtoMessage() {}
+
+func (x *GetMemoGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoGraphRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoGraphRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{23}
+}
+
+type MemoEdge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      string                 `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	TargetId      string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoEdge) Reset() {
+	*x = MemoEdge{}
+	mi := &file_proto_api_memo_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoEdge) ProtoMessage() {}
+
+func (x *MemoEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoEdge.ProtoReflect.Descriptor instead.
+func (*MemoEdge) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MemoEdge) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *MemoEdge) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+type GetMemoGraphResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*MemoRef             `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges         []*MemoEdge            `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoGraphResponse) Reset() {
+	*x = GetMemoGraphResponse{}
+	mi := &file_proto_api_memo_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoGraphResponse) ProtoMessage() {}
+
+func (x *GetMemoGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoGraphResponse.ProtoReflect.Descriptor instead.
+func (*GetMemoGraphResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetMemoGraphResponse) GetNodes() []*MemoRef {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *GetMemoGraphResponse) GetEdges() []*MemoEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+type MemoCommit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	AuthorName    string                 `protobuf:"bytes,3,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	AuthorEmail   string                 `protobuf:"bytes,4,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
+	CommittedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=committed_at,json=committedAt,proto3" json:"committed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoCommit) Reset() {
+	*x = MemoCommit{}
+	mi := &file_proto_api_memo_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoCommit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoCommit) ProtoMessage() {}
+
+func (x *MemoCommit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoCommit.ProtoReflect.Descriptor instead.
+func (*MemoCommit) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MemoCommit) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *MemoCommit) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MemoCommit) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *MemoCommit) GetAuthorEmail() string {
+	if x != nil {
+		return x.AuthorEmail
+	}
+	return ""
+}
+
+func (x *MemoCommit) GetCommittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CommittedAt
+	}
+	return nil
+}
+
+type GetMemoHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Zero returns the full history.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoHistoryRequest) Reset() {
+	*x = GetMemoHistoryRequest{}
+	mi := &file_proto_api_memo_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoHistoryRequest) ProtoMessage() {}
+
+func (x *GetMemoHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetMemoHistoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetMemoHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetMemoHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Commits       []*MemoCommit          `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoHistoryResponse) Reset() {
+	*x = GetMemoHistoryResponse{}
+	mi := &file_proto_api_memo_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoHistoryResponse) ProtoMessage() {}
+
+func (x *GetMemoHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetMemoHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetMemoHistoryResponse) GetCommits() []*MemoCommit {
+	if x != nil {
+		return x.Commits
+	}
+	return nil
+}
+
+type RevertMemoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CommitHash    string                 `protobuf:"bytes,2,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertMemoRequest) Reset() {
+	*x = RevertMemoRequest{}
+	mi := &file_proto_api_memo_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertMemoRequest) ProtoMessage() {}
+
+func (x *RevertMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertMemoRequest.ProtoReflect.Descriptor instead.
+func (*RevertMemoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RevertMemoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RevertMemoRequest) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+type RevertMemoResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Memo  *Memo                  `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Unset when the memo already matched the commit.
+	Commit        *MemoCommit `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertMemoResponse) Reset() {
+	*x = RevertMemoResponse{}
+	mi := &file_proto_api_memo_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertMemoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertMemoResponse) ProtoMessage() {}
+
+func (x *RevertMemoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertMemoResponse.ProtoReflect.Descriptor instead.
+func (*RevertMemoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RevertMemoResponse) GetMemo() *Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *RevertMemoResponse) GetCommit() *MemoCommit {
+	if x != nil {
+		return x.Commit
+	}
+	return nil
+}
+
+type Notebook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Path  string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Number of memos directly in the notebook.
+	MemoCount     int32 `protobuf:"varint,2,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notebook) Reset() {
+	*x = Notebook{}
+	mi := &file_proto_api_memo_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notebook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notebook) ProtoMessage() {}
+
+func (x *Notebook) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notebook.ProtoReflect.Descriptor instead.
+func (*Notebook) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Notebook) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Notebook) GetMemoCount() int32 {
+	if x != nil {
+		return x.MemoCount
+	}
+	return 0
+}
+
+type CreateNotebookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNotebookRequest) Reset() {
+	*x = CreateNotebookRequest{}
+	mi := &file_proto_api_memo_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNotebookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNotebookRequest) ProtoMessage() {}
+
+func (x *CreateNotebookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNotebookRequest.ProtoReflect.Descriptor instead.
+func (*CreateNotebookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateNotebookRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type CreateNotebookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notebook      *Notebook              `protobuf:"bytes,1,opt,name=notebook,proto3" json:"notebook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNotebookResponse) Reset() {
+	*x = CreateNotebookResponse{}
+	mi := &file_proto_api_memo_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNotebookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNotebookResponse) ProtoMessage() {}
+
+func (x *CreateNotebookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNotebookResponse.ProtoReflect.Descriptor instead.
+func (*CreateNotebookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateNotebookResponse) GetNotebook() *Notebook {
+	if x != nil {
+		return x.Notebook
+	}
+	return nil
+}
+
+type ListNotebooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotebooksRequest) Reset() {
+	*x = ListNotebooksRequest{}
+	mi := &file_proto_api_memo_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotebooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotebooksRequest) ProtoMessage() {}
+
+func (x *ListNotebooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotebooksRequest.ProtoReflect.Descriptor instead.
+func (*ListNotebooksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{34}
+}
+
+type ListNotebooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notebooks     []*Notebook            `protobuf:"bytes,1,rep,name=notebooks,proto3" json:"notebooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotebooksResponse) Reset() {
+	*x = ListNotebooksResponse{}
+	mi := &file_proto_api_memo_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotebooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotebooksResponse) ProtoMessage() {}
+
+func (x *ListNotebooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotebooksResponse.ProtoReflect.Descriptor instead.
+func (*ListNotebooksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListNotebooksResponse) GetNotebooks() []*Notebook {
+	if x != nil {
+		return x.Notebooks
+	}
+	return nil
+}
+
+type MoveMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Empty moves the memo to the top level.
+	Notebook      string `protobuf:"bytes,2,opt,name=notebook,proto3" json:"notebook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveMemoRequest) Reset() {
+	*x = MoveMemoRequest{}
+	mi := &file_proto_api_memo_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveMemoRequest) ProtoMessage() {}
+
+func (x *MoveMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveMemoRequest.ProtoReflect.Descriptor instead.
+func (*MoveMemoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MoveMemoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveMemoRequest) GetNotebook() string {
+	if x != nil {
+		return x.Notebook
+	}
+	return ""
+}
+
+type MoveMemoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Memo          *Memo                  `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveMemoResponse) Reset() {
+	*x = MoveMemoResponse{}
+	mi := &file_proto_api_memo_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveMemoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveMemoResponse) ProtoMessage() {}
+
+func (x *MoveMemoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveMemoResponse.ProtoReflect.Descriptor instead.
+func (*MoveMemoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MoveMemoResponse) GetMemo() *Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+type DeleteNotebookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Path  string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Deletes the memos and sub-notebooks inside as well.
+	Recursive     bool `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNotebookRequest) Reset() {
+	*x = DeleteNotebookRequest{}
+	mi := &file_proto_api_memo_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotebookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotebookRequest) ProtoMessage() {}
+
+func (x *DeleteNotebookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotebookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNotebookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeleteNotebookRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DeleteNotebookRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+type DeleteNotebookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNotebookResponse) Reset() {
+	*x = DeleteNotebookResponse{}
+	mi := &file_proto_api_memo_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotebookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotebookResponse) ProtoMessage() {}
+
+func (x *DeleteNotebookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotebookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNotebookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeleteNotebookResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CheckIntegrityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Rename or rewrite files that can be fixed in place.
+	Repair bool `protobuf:"varint,1,opt,name=repair,proto3" json:"repair,omitempty"`
+	// Move files that cannot be repaired into the .quarantine directory.
+	Quarantine    bool `protobuf:"varint,2,opt,name=quarantine,proto3" json:"quarantine,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckIntegrityRequest) Reset() {
+	*x = CheckIntegrityRequest{}
+	mi := &file_proto_api_memo_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckIntegrityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckIntegrityRequest) ProtoMessage() {}
+
+func (x *CheckIntegrityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckIntegrityRequest.ProtoReflect.Descriptor instead.
+func (*CheckIntegrityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CheckIntegrityRequest) GetRepair() bool {
+	if x != nil {
+		return x.Repair
+	}
+	return false
+}
+
+func (x *CheckIntegrityRequest) GetQuarantine() bool {
+	if x != nil {
+		return x.Quarantine
+	}
+	return false
+}
+
+type IntegrityIssue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  IntegrityIssueKind     `protobuf:"varint,1,opt,name=kind,proto3,enum=memo.IntegrityIssueKind" json:"kind,omitempty"`
+	// Path relative to the memo folder.
+	Path          string          `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	MemoId        string          `protobuf:"bytes,3,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	Detail        string          `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+	Action        IntegrityAction `protobuf:"varint,5,opt,name=action,proto3,enum=memo.IntegrityAction" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntegrityIssue) Reset() {
+	*x = IntegrityIssue{}
+	mi := &file_proto_api_memo_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntegrityIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrityIssue) ProtoMessage() {}
+
+func (x *IntegrityIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrityIssue.ProtoReflect.Descriptor instead.
+func (*IntegrityIssue) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *IntegrityIssue) GetKind() IntegrityIssueKind {
+	if x != nil {
+		return x.Kind
+	}
+	return IntegrityIssueKind_INTEGRITY_ISSUE_KIND_UNSPECIFIED
+}
+
+func (x *IntegrityIssue) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *IntegrityIssue) GetMemoId() string {
+	if x != nil {
+		return x.MemoId
+	}
+	return ""
+}
+
+func (x *IntegrityIssue) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *IntegrityIssue) GetAction() IntegrityAction {
+	if x != nil {
+		return x.Action
+	}
+	return IntegrityAction_INTEGRITY_ACTION_UNSPECIFIED
+}
+
+type CheckIntegrityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScannedFiles  int32                  `protobuf:"varint,1,opt,name=scanned_files,json=scannedFiles,proto3" json:"scanned_files,omitempty"`
+	Issues        []*IntegrityIssue      `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckIntegrityResponse) Reset() {
+	*x = CheckIntegrityResponse{}
+	mi := &file_proto_api_memo_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckIntegrityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckIntegrityResponse) ProtoMessage() {}
+
+func (x *CheckIntegrityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckIntegrityResponse.ProtoReflect.Descriptor instead.
+func (*CheckIntegrityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CheckIntegrityResponse) GetScannedFiles() int32 {
+	if x != nil {
+		return x.ScannedFiles
+	}
+	return 0
+}
+
+func (x *CheckIntegrityResponse) GetIssues() []*IntegrityIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type Snapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	mi := &file_proto_api_memo_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Snapshot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Snapshot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Snapshot) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type CreateSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSnapshotRequest) Reset() {
+	*x = CreateSnapshotRequest{}
+	mi := &file_proto_api_memo_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSnapshotRequest) ProtoMessage() {}
+
+func (x *CreateSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*CreateSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{44}
+}
+
+type CreateSnapshotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot      *Snapshot              `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSnapshotResponse) Reset() {
+	*x = CreateSnapshotResponse{}
+	mi := &file_proto_api_memo_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSnapshotResponse) ProtoMessage() {}
+
+func (x *CreateSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*CreateSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateSnapshotResponse) GetSnapshot() *Snapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type ListSnapshotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSnapshotsRequest) Reset() {
+	*x = ListSnapshotsRequest{}
+	mi := &file_proto_api_memo_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{46}
+}
+
+type ListSnapshotsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newest first.
+	Snapshots     []*Snapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSnapshotsResponse) Reset() {
+	*x = ListSnapshotsResponse{}
+	mi := &file_proto_api_memo_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotsResponse) ProtoMessage() {}
+
+func (x *ListSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListSnapshotsResponse) GetSnapshots() []*Snapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type RestoreSnapshotRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotId string                 `protobuf:"bytes,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	// Restores only these memos. When empty, the whole folder is replaced by
+	// the snapshot after a backup snapshot of it has been taken.
+	MemoIds       []string `protobuf:"bytes,2,rep,name=memo_ids,json=memoIds,proto3" json:"memo_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreSnapshotRequest) Reset() {
+	*x = RestoreSnapshotRequest{}
+	mi := &file_proto_api_memo_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSnapshotRequest) ProtoMessage() {}
+
+func (x *RestoreSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*RestoreSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RestoreSnapshotRequest) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+func (x *RestoreSnapshotRequest) GetMemoIds() []string {
+	if x != nil {
+		return x.MemoIds
+	}
+	return nil
+}
+
+type RestoreSnapshotResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Folder-relative paths of the restored files.
+	RestoredPaths []string `protobuf:"bytes,1,rep,name=restored_paths,json=restoredPaths,proto3" json:"restored_paths,omitempty"`
+	// The backup taken before a whole-folder restore.
+	Backup        *Snapshot `protobuf:"bytes,2,opt,name=backup,proto3" json:"backup,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreSnapshotResponse) Reset() {
+	*x = RestoreSnapshotResponse{}
+	mi := &file_proto_api_memo_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSnapshotResponse) ProtoMessage() {}
+
+func (x *RestoreSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*RestoreSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RestoreSnapshotResponse) GetRestoredPaths() []string {
+	if x != nil {
+		return x.RestoredPaths
+	}
+	return nil
+}
+
+func (x *RestoreSnapshotResponse) GetBackup() *Snapshot {
+	if x != nil {
+		return x.Backup
+	}
+	return nil
+}
+
+// EditOp is exchanged in both directions of EditSession.
+// Positions and lengths count Unicode code points.
+type EditOp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The memo being edited. Required on the first message from a client.
+	MemoId string `protobuf:"bytes,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	// From clients: the revision the operation or cursor is based on.
+	// From the server: the revision after the message.
+	Revision int64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// The participant the message is about. Set by the server; a client
+	// recognizes acknowledgements of its own operations by it.
+	ClientId string `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// Display name of the participant. Set by the server.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*EditOp_Join
+	//	*EditOp_Snapshot
+	//	*EditOp_Operation
+	//	*EditOp_Cursor
+	//	*EditOp_Presence
+	Payload       isEditOp_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditOp) Reset() {
+	*x = EditOp{}
+	mi := &file_proto_api_memo_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditOp) ProtoMessage() {}
+
+func (x *EditOp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditOp.ProtoReflect.Descriptor instead.
+func (*EditOp) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *EditOp) GetMemoId() string {
+	if x != nil {
+		return x.MemoId
+	}
+	return ""
+}
+
+func (x *EditOp) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *EditOp) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *EditOp) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EditOp) GetPayload() isEditOp_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *EditOp) GetJoin() *EditJoin {
+	if x != nil {
+		if x, ok := x.Payload.(*EditOp_Join); ok {
+			return x.Join
+		}
+	}
+	return nil
+}
+
+func (x *EditOp) GetSnapshot() *EditSnapshot {
+	if x != nil {
+		if x, ok := x.Payload.(*EditOp_Snapshot); ok {
+			return x.Snapshot
+		}
+	}
+	return nil
+}
+
+func (x *EditOp) GetOperation() *TextOperation {
+	if x != nil {
+		if x, ok := x.Payload.(*EditOp_Operation); ok {
+			return x.Operation
+		}
+	}
+	return nil
+}
+
+func (x *EditOp) GetCursor() *EditCursor {
+	if x != nil {
+		if x, ok := x.Payload.(*EditOp_Cursor); ok {
+			return x.Cursor
+		}
+	}
+	return nil
+}
+
+func (x *EditOp) GetPresence() *EditPresence {
+	if x != nil {
+		if x, ok := x.Payload.(*EditOp_Presence); ok {
+			return x.Presence
+		}
+	}
+	return nil
+}
+
+type isEditOp_Payload interface {
+	isEditOp_Payload()
+}
+
+type EditOp_Join struct {
+	// Client: joins the session. Must be the first message.
+	Join *EditJoin `protobuf:"bytes,5,opt,name=join,proto3,oneof"`
+}
+
+type EditOp_Snapshot struct {
+	// Server: the document and participants when joining.
+	Snapshot *EditSnapshot `protobuf:"bytes,6,opt,name=snapshot,proto3,oneof"`
+}
+
+type EditOp_Operation struct {
+	// Both: a change of the document.
+	Operation *TextOperation `protobuf:"bytes,7,opt,name=operation,proto3,oneof"`
+}
+
+type EditOp_Cursor struct {
+	// Both: a cursor or selection move.
+	Cursor *EditCursor `protobuf:"bytes,8,opt,name=cursor,proto3,oneof"`
+}
+
+type EditOp_Presence struct {
+	// Server: a participant joined or left.
+	Presence *EditPresence `protobuf:"bytes,9,opt,name=presence,proto3,oneof"`
+}
+
+func (*EditOp_Join) isEditOp_Payload() {}
+
+func (*EditOp_Snapshot) isEditOp_Payload() {}
+
+func (*EditOp_Operation) isEditOp_Payload() {}
+
+func (*EditOp_Cursor) isEditOp_Payload() {}
+
+func (*EditOp_Presence) isEditOp_Payload() {}
+
+type EditJoin struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Shown to other participants. Defaults to the authenticated user's name.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditJoin) Reset() {
+	*x = EditJoin{}
+	mi := &file_proto_api_memo_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditJoin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditJoin) ProtoMessage() {}
+
+func (x *EditJoin) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditJoin.ProtoReflect.Descriptor instead.
+func (*EditJoin) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *EditJoin) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type EditSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Participants  []*EditParticipant     `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditSnapshot) Reset() {
+	*x = EditSnapshot{}
+	mi := &file_proto_api_memo_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditSnapshot) ProtoMessage() {}
+
+func (x *EditSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditSnapshot.ProtoReflect.Descriptor instead.
+func (*EditSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *EditSnapshot) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *EditSnapshot) GetParticipants() []*EditParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+type EditParticipant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Cursor        *EditCursor            `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditParticipant) Reset() {
+	*x = EditParticipant{}
+	mi := &file_proto_api_memo_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditParticipant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditParticipant) ProtoMessage() {}
+
+func (x *EditParticipant) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditParticipant.ProtoReflect.Descriptor instead.
+func (*EditParticipant) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *EditParticipant) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *EditParticipant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EditParticipant) GetCursor() *EditCursor {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+// TextOperation walks the whole document: each component retains, inserts
+// or deletes text at the current position.
+type TextOperation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Components    []*TextComponent       `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextOperation) Reset() {
+	*x = TextOperation{}
+	mi := &file_proto_api_memo_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextOperation) ProtoMessage() {}
+
+func (x *TextOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextOperation.ProtoReflect.Descriptor instead.
+func (*TextOperation) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *TextOperation) GetComponents() []*TextComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+type TextComponent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Component:
+	//
+	//	*TextComponent_Retain
+	//	*TextComponent_Insert
+	//	*TextComponent_Delete
+	Component     isTextComponent_Component `protobuf_oneof:"component"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextComponent) Reset() {
+	*x = TextComponent{}
+	mi := &file_proto_api_memo_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextComponent) ProtoMessage() {}
+
+func (x *TextComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextComponent.ProtoReflect.Descriptor instead.
+func (*TextComponent) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *TextComponent) GetComponent() isTextComponent_Component {
+	if x != nil {
+		return x.Component
+	}
+	return nil
+}
+
+func (x *TextComponent) GetRetain() int32 {
+	if x != nil {
+		if x, ok := x.Component.(*TextComponent_Retain); ok {
+			return x.Retain
+		}
+	}
+	return 0
+}
+
+func (x *TextComponent) GetInsert() string {
+	if x != nil {
+		if x, ok := x.Component.(*TextComponent_Insert); ok {
+			return x.Insert
+		}
+	}
+	return ""
+}
+
+func (x *TextComponent) GetDelete() int32 {
+	if x != nil {
+		if x, ok := x.Component.(*TextComponent_Delete); ok {
+			return x.Delete
+		}
+	}
+	return 0
+}
+
+type isTextComponent_Component interface {
+	isTextComponent_Component()
+}
+
+type TextComponent_Retain struct {
+	Retain int32 `protobuf:"varint,1,opt,name=retain,proto3,oneof"`
+}
+
+type TextComponent_Insert struct {
+	Insert string `protobuf:"bytes,2,opt,name=insert,proto3,oneof"`
+}
+
+type TextComponent_Delete struct {
+	Delete int32 `protobuf:"varint,3,opt,name=delete,proto3,oneof"`
+}
+
+func (*TextComponent_Retain) isTextComponent_Component() {}
+
+func (*TextComponent_Insert) isTextComponent_Component() {}
+
+func (*TextComponent_Delete) isTextComponent_Component() {}
+
+type EditCursor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Position      int32                  `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	SelectionEnd  int32                  `protobuf:"varint,2,opt,name=selection_end,json=selectionEnd,proto3" json:"selection_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCursor) Reset() {
+	*x = EditCursor{}
+	mi := &file_proto_api_memo_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCursor) ProtoMessage() {}
+
+func (x *EditCursor) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCursor.ProtoReflect.Descriptor instead.
+func (*EditCursor) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *EditCursor) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *EditCursor) GetSelectionEnd() int32 {
+	if x != nil {
+		return x.SelectionEnd
+	}
+	return 0
+}
+
+type EditPresence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Online        bool                   `protobuf:"varint,1,opt,name=online,proto3" json:"online,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditPresence) Reset() {
+	*x = EditPresence{}
+	mi := &file_proto_api_memo_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditPresence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditPresence) ProtoMessage() {}
+
+func (x *EditPresence) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditPresence.ProtoReflect.Descriptor instead.
+func (*EditPresence) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *EditPresence) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+type Reminder struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MemoId   string                 `protobuf:"bytes,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	RemindAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=remind_at,json=remindAt,proto3" json:"remind_at,omitempty"`
+	// Unset when the memo no longer exists.
+	Memo *Memo `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+	// When the reminder was first delivered. Unset until then.
+	DeliveredAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reminder) Reset() {
+	*x = Reminder{}
+	mi := &file_proto_api_memo_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reminder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reminder) ProtoMessage() {}
+
+func (x *Reminder) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reminder.ProtoReflect.Descriptor instead.
+func (*Reminder) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *Reminder) GetMemoId() string {
+	if x != nil {
+		return x.MemoId
+	}
+	return ""
+}
+
+func (x *Reminder) GetRemindAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindAt
+	}
+	return nil
+}
+
+func (x *Reminder) GetMemo() *Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *Reminder) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+type StreamRemindersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamRemindersRequest) Reset() {
+	*x = StreamRemindersRequest{}
+	mi := &file_proto_api_memo_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamRemindersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamRemindersRequest) ProtoMessage() {}
+
+func (x *StreamRemindersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamRemindersRequest.ProtoReflect.Descriptor instead.
+func (*StreamRemindersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{59}
+}
+
+type SnoozeReminderRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	MemoId string                 `protobuf:"bytes,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	// Types that are valid to be assigned to Until:
+	//
+	//	*SnoozeReminderRequest_RemindAt
+	//	*SnoozeReminderRequest_Duration
+	Until         isSnoozeReminderRequest_Until `protobuf_oneof:"until"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnoozeReminderRequest) Reset() {
+	*x = SnoozeReminderRequest{}
+	mi := &file_proto_api_memo_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnoozeReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnoozeReminderRequest) ProtoMessage() {}
+
+func (x *SnoozeReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnoozeReminderRequest.ProtoReflect.Descriptor instead.
+func (*SnoozeReminderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SnoozeReminderRequest) GetMemoId() string {
+	if x != nil {
+		return x.MemoId
+	}
+	return ""
+}
+
+func (x *SnoozeReminderRequest) GetUntil() isSnoozeReminderRequest_Until {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *SnoozeReminderRequest) GetRemindAt() *timestamppb.Timestamp {
+	if x != nil {
+		if x, ok := x.Until.(*SnoozeReminderRequest_RemindAt); ok {
+			return x.RemindAt
+		}
+	}
+	return nil
+}
+
+func (x *SnoozeReminderRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		if x, ok := x.Until.(*SnoozeReminderRequest_Duration); ok {
+			return x.Duration
+		}
+	}
+	return nil
+}
+
+type isSnoozeReminderRequest_Until interface {
+	isSnoozeReminderRequest_Until()
+}
+
+type SnoozeReminderRequest_RemindAt struct {
+	RemindAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=remind_at,json=remindAt,proto3,oneof"`
+}
+
+type SnoozeReminderRequest_Duration struct {
+	// Snoozes for this long from now.
+	Duration *durationpb.Duration `protobuf:"bytes,3,opt,name=duration,proto3,oneof"`
+}
+
+func (*SnoozeReminderRequest_RemindAt) isSnoozeReminderRequest_Until() {}
+
+func (*SnoozeReminderRequest_Duration) isSnoozeReminderRequest_Until() {}
+
+type SnoozeReminderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reminder      *Reminder              `protobuf:"bytes,1,opt,name=reminder,proto3" json:"reminder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnoozeReminderResponse) Reset() {
+	*x = SnoozeReminderResponse{}
+	mi := &file_proto_api_memo_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnoozeReminderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnoozeReminderResponse) ProtoMessage() {}
+
+func (x *SnoozeReminderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnoozeReminderResponse.ProtoReflect.Descriptor instead.
+func (*SnoozeReminderResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SnoozeReminderResponse) GetReminder() *Reminder {
+	if x != nil {
+		return x.Reminder
+	}
+	return nil
+}
+
+type DismissReminderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemoId        string                 `protobuf:"bytes,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DismissReminderRequest) Reset() {
+	*x = DismissReminderRequest{}
+	mi := &file_proto_api_memo_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DismissReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissReminderRequest) ProtoMessage() {}
+
+func (x *DismissReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissReminderRequest.ProtoReflect.Descriptor instead.
+func (*DismissReminderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DismissReminderRequest) GetMemoId() string {
+	if x != nil {
+		return x.MemoId
+	}
+	return ""
+}
+
+type DismissReminderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DismissReminderResponse) Reset() {
+	*x = DismissReminderResponse{}
+	mi := &file_proto_api_memo_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DismissReminderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissReminderResponse) ProtoMessage() {}
+
+func (x *DismissReminderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissReminderResponse.ProtoReflect.Descriptor instead.
+func (*DismissReminderResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{63}
+}
+
+// MemoTemplate is rendered with Go text/template when a memo is created from it.
+// Besides the request variables it can use {{.Title}}, {{.Date}}, {{.Time}},
+// {{.DateTime}}, {{.Weekday}}, {{.User}} and {{.UserEmail}}, and the functions
+// upper, lower, trim and default. Use {{index . "name"}} for optional variables.
+type MemoTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoTemplate) Reset() {
+	*x = MemoTemplate{}
+	mi := &file_proto_api_memo_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoTemplate) ProtoMessage() {}
+
+func (x *MemoTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoTemplate.ProtoReflect.Descriptor instead.
+func (*MemoTemplate) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *MemoTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MemoTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemoTemplate) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MemoTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MemoTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListMemoTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoTemplatesRequest) Reset() {
+	*x = ListMemoTemplatesRequest{}
+	mi := &file_proto_api_memo_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoTemplatesRequest) ProtoMessage() {}
+
+func (x *ListMemoTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{65}
+}
+
+type ListMemoTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*MemoTemplate        `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoTemplatesResponse) Reset() {
+	*x = ListMemoTemplatesResponse{}
+	mi := &file_proto_api_memo_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoTemplatesResponse) ProtoMessage() {}
+
+func (x *ListMemoTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListMemoTemplatesResponse) GetTemplates() []*MemoTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type CreateMemoTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemoTemplateRequest) Reset() {
+	*x = CreateMemoTemplateRequest{}
+	mi := &file_proto_api_memo_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemoTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemoTemplateRequest) ProtoMessage() {}
+
+func (x *CreateMemoTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemoTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemoTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreateMemoTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateMemoTemplateRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type CreateMemoTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *MemoTemplate          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemoTemplateResponse) Reset() {
+	*x = CreateMemoTemplateResponse{}
+	mi := &file_proto_api_memo_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemoTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemoTemplateResponse) ProtoMessage() {}
+
+func (x *CreateMemoTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemoTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateMemoTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CreateMemoTemplateResponse) GetTemplate() *MemoTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type DeleteMemoTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemoTemplateRequest) Reset() {
+	*x = DeleteMemoTemplateRequest{}
+	mi := &file_proto_api_memo_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemoTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemoTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteMemoTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemoTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DeleteMemoTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteMemoTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemoTemplateResponse) Reset() {
+	*x = DeleteMemoTemplateResponse{}
+	mi := &file_proto_api_memo_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemoTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemoTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteMemoTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemoTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMemoTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{70}
+}
+
+type GetUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageRequest) Reset() {
+	*x = GetUsageRequest{}
+	mi := &file_proto_api_memo_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageRequest) ProtoMessage() {}
+
+func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{71}
+}
+
+// QuotaLimits are the limits of a quota scope. 0 means unlimited.
+type QuotaLimits struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MaxContentBytes int64                  `protobuf:"varint,1,opt,name=max_content_bytes,json=maxContentBytes,proto3" json:"max_content_bytes,omitempty"`
+	MaxMemos        int64                  `protobuf:"varint,2,opt,name=max_memos,json=maxMemos,proto3" json:"max_memos,omitempty"`
+	MaxTotalBytes   int64                  `protobuf:"varint,3,opt,name=max_total_bytes,json=maxTotalBytes,proto3" json:"max_total_bytes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *QuotaLimits) Reset() {
+	*x = QuotaLimits{}
+	mi := &file_proto_api_memo_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaLimits) ProtoMessage() {}
+
+func (x *QuotaLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaLimits.ProtoReflect.Descriptor instead.
+func (*QuotaLimits) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *QuotaLimits) GetMaxContentBytes() int64 {
+	if x != nil {
+		return x.MaxContentBytes
+	}
+	return 0
+}
+
+func (x *QuotaLimits) GetMaxMemos() int64 {
+	if x != nil {
+		return x.MaxMemos
+	}
+	return 0
+}
+
+func (x *QuotaLimits) GetMaxTotalBytes() int64 {
+	if x != nil {
+		return x.MaxTotalBytes
+	}
+	return 0
+}
+
+type GetUsageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// scope is what the limits apply to: "user:<id>" or "folder".
+	Scope     string `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	MemoCount int64  `protobuf:"varint,2,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	// total_bytes is the size of the memo files on disk.
+	TotalBytes    int64        `protobuf:"varint,3,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	Limits        *QuotaLimits `protobuf:"bytes,4,opt,name=limits,proto3" json:"limits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageResponse) Reset() {
+	*x = GetUsageResponse{}
+	mi := &file_proto_api_memo_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageResponse) ProtoMessage() {}
+
+func (x *GetUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_memo_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_memo_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetUsageResponse) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *GetUsageResponse) GetMemoCount() int64 {
+	if x != nil {
+		return x.MemoCount
+	}
+	return 0
+}
+
+func (x *GetUsageResponse) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *GetUsageResponse) GetLimits() *QuotaLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+var File_proto_api_memo_proto protoreflect.FileDescriptor
+
+const file_proto_api_memo_proto_rawDesc = "" +
+	"\n" +
+	"\x14proto/api/memo.proto\x12\x04memo\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa0\x03\n" +
+	"\x04Memo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12:\n" +
+	"\n" +
+	"properties\x18\a \x03(\v2\x1a.memo.Memo.PropertiesEntryR\n" +
+	"properties\x12\x1a\n" +
+	"\bnotebook\x18\b \x01(\tR\bnotebook\x127\n" +
+	"\tremind_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bremindAt\x1a=\n" +
+	"\x0fPropertiesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbd\x02\n" +
+	"\x11CreateMemoRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1a\n" +
+	"\bnotebook\x18\x03 \x01(\tR\bnotebook\x127\n" +
+	"\tremind_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bremindAt\x12\x1f\n" +
+	"\vtemplate_id\x18\x05 \x01(\tR\n" +
+	"templateId\x12D\n" +
+	"\tvariables\x18\x06 \x03(\v2&.memo.CreateMemoRequest.VariablesEntryR\tvariables\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"4\n" +
+	"\x12CreateMemoResponse\x12\x1e\n" +
+	"\x04memo\x18\x01 \x01(\v2\n" +
+	".memo.MemoR\x04memo\"I\n" +
+	"\x17CreateMemoByJsonRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\":\n" +
+	"\x18CreateMemoByJsonResponse\x12\x1e\n" +
+	"\x04memo\x18\x01 \x01(\v2\n" +
+	".memo.MemoR\x04memo\" \n" +
+	"\x0eGetMemoRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
+	"\x0fGetMemoResponse\x12\x1e\n" +
+	"\x04memo\x18\x01 \x01(\v2\n" +
+	".memo.MemoR\x04memo\"0\n" +
+	"\x13GetMultiMemoRequest\x12\x19\n" +
+	"\bmemo_ids\x18\x01 \x03(\tR\amemoIds\"X\n" +
+	"\x14GetMultiMemoResponse\x12\x1e\n" +
+	"\x04memo\x18\x01 \x01(\v2\n" +
+	".memo.MemoR\x04memo\x12 \n" +
+	"\x05memos\x18\x02 \x03(\v2\n" +
+	".memo.MemoR\x05memos\"\x91\x03\n" +
+	"\x10ListMemosRequest\x12>\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tstartTime\x88\x01\x01\x12:\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\aendTime\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12F\n" +
+	"\n" +
+	"properties\x18\x04 \x03(\v2&.memo.ListMemosRequest.PropertiesEntryR\n" +
+	"properties\x12\x1f\n" +
+	"\bnotebook\x18\x05 \x01(\tH\x02R\bnotebook\x88\x01\x01\x12\x1c\n" +
+	"\trecursive\x18\x06 \x01(\bR\trecursive\x1a=\n" +
+	"\x0fPropertiesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_timeB\v\n" +
+	"\t_notebook\"5\n" +
+	"\x11ListMemosResponse\x12 \n" +
+	"\x05memos\x18\x01 \x03(\v2\n" +
+	".memo.MemoR\x05memos\"\xc2\x01\n" +
+	"\x11UpdateMemoRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x19\n" +
+	"\x05title\x18\x03 \x01(\tH\x00R\x05title\x88\x01\x01\x127\n" +
+	"\tremind_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bremindAt\x12%\n" +
+	"\x0eclear_reminder\x18\x05 \x01(\bR\rclearReminderB\b\n" +
+	"\x06_title\"4\n" +
+	"\x12UpdateMemoResponse\x12\x1e\n" +
+	"\x04memo\x18\x01 \x01(\v2\n" +
+	".memo.MemoR\x04memo\"W\n" +
+	"\rExportRequest\x12\x19\n" +
+	"\bmemo_ids\x18\x01 \x03(\tR\amemoIds\x12+\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x13.memo.ArchiveFormatR\x06format\"\x8e\x01\n" +
+	"\fArchiveChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12+\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x13.memo.ArchiveFormatR\x06format\x12=\n" +
+	"\x0fconflict_policy\x18\x03 \x01(\x0e2\x14.memo.ConflictPolicyR\x0econflictPolicy\"\x8d\x01\n" +
+	"\fImportResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12*\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x12.memo.ImportStatusR\x06status\x12\x15\n" +
+	"\x06new_id\x18\x04 \x01(\tR\x05newId\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"C\n" +
+	"\x13ImportMemosResponse\x12,\n" +
+	"\aresults\x18\x01 \x03(\v2\x12.memo.ImportResultR\aresults\"\x15\n" +
+	"\x13ListMemoTagsRequest\"2\n" +
+	"\bTagCount\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\":\n" +
+	"\x14ListMemoTagsResponse\x12\"\n" +
+	"\x04tags\x18\x01 \x03(\v2\x0e.memo.TagCountR\x04tags\"/\n" +
+	"\aMemoRef\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\"%\n" +
+	"\x13GetMemoLinksRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x8e\x01\n" +
+	"\x14GetMemoLinksResponse\x12)\n" +
+	"\boutgoing\x18\x01 \x03(\v2\r.memo.MemoRefR\boutgoing\x12+\n" +
+	"\tbacklinks\x18\x02 \x03(\v2\r.memo.MemoRefR\tbacklinks\x12\x1e\n" +
+	"\n" +
+	"unresolved\x18\x03 \x03(\tR\n" +
+	"unresolved\"\x15\n" +
+	"\x13GetMemoGraphRequest\"D\n" +
+	"\bMemoEdge\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\"a\n" +
+	"\x14GetMemoGraphResponse\x12#\n" +
+	"\x05nodes\x18\x01 \x03(\v2\r.memo.MemoRefR\x05nodes\x12$\n" +
+	"\x05edges\x18\x02 \x03(\v2\x0e.memo.MemoEdgeR\x05edges\"\xbd\x01\n" +
+	"\n" +
+	"MemoCommit\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
+	"\vauthor_name\x18\x03 \x01(\tR\n" +
+	"authorName\x12!\n" +
+	"\fauthor_email\x18\x04 \x01(\tR\vauthorEmail\x12=\n" +
+	"\fcommitted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vcommittedAt\"=\n" +
+	"\x15GetMemoHistoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"D\n" +
+	"\x16GetMemoHistoryResponse\x12*\n" +
+	"\acommits\x18\x01 \x03(\v2\x10.memo.MemoCommitR\acommits\"D\n" +
+	"\x11RevertMemoRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcommit_hash\x18\x02 \x01(\tR\n" +
+	"commitHash\"^\n" +
+	"\x12RevertMemoResponse\x12\x1e\n" +
+	"\x04memo\x18\x01 \x01(\v2\n" +
+	".memo.MemoR\x04memo\x12(\n" +
+	"\x06commit\x18\x02 \x01(\v2\x10.memo.MemoCommitR\x06commit\"=\n" +
+	"\bNotebook\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1d\n" +
+	"\n" +
+	"memo_count\x18\x02 \x01(\x05R\tmemoCount\"+\n" +
+	"\x15CreateNotebookRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\"D\n" +
+	"\x16CreateNotebookResponse\x12*\n" +
+	"\bnotebook\x18\x01 \x01(\v2\x0e.memo.NotebookR\bnotebook\"\x16\n" +
+	"\x14ListNotebooksRequest\"E\n" +
+	"\x15ListNotebooksResponse\x12,\n" +
+	"\tnotebooks\x18\x01 \x03(\v2\x0e.memo.NotebookR\tnotebooks\"=\n" +
+	"\x0fMoveMemoRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bnotebook\x18\x02 \x01(\tR\bnotebook\"2\n" +
+	"\x10MoveMemoResponse\x12\x1e\n" +
+	"\x04memo\x18\x01 \x01(\v2\n" +
+	".memo.MemoR\x04memo\"I\n" +
+	"\x15DeleteNotebookRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1c\n" +
+	"\trecursive\x18\x02 \x01(\bR\trecursive\"2\n" +
+	"\x16DeleteNotebookResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"O\n" +
+	"\x15CheckIntegrityRequest\x12\x16\n" +
+	"\x06repair\x18\x01 \x01(\bR\x06repair\x12\x1e\n" +
+	"\n" +
+	"quarantine\x18\x02 \x01(\bR\n" +
+	"quarantine\"\xb2\x01\n" +
+	"\x0eIntegrityIssue\x12,\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x18.memo.IntegrityIssueKindR\x04kind\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x17\n" +
+	"\amemo_id\x18\x03 \x01(\tR\x06memoId\x12\x16\n" +
+	"\x06detail\x18\x04 \x01(\tR\x06detail\x12-\n" +
+	"\x06action\x18\x05 \x01(\x0e2\x15.memo.IntegrityActionR\x06action\"k\n" +
+	"\x16CheckIntegrityResponse\x12#\n" +
+	"\rscanned_files\x18\x01 \x01(\x05R\fscannedFiles\x12,\n" +
+	"\x06issues\x18\x02 \x03(\v2\x14.memo.IntegrityIssueR\x06issues\"t\n" +
+	"\bSnapshot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x03 \x01(\x03R\tsizeBytes\"\x17\n" +
+	"\x15CreateSnapshotRequest\"D\n" +
+	"\x16CreateSnapshotResponse\x12*\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x0e.memo.SnapshotR\bsnapshot\"\x16\n" +
+	"\x14ListSnapshotsRequest\"E\n" +
+	"\x15ListSnapshotsResponse\x12,\n" +
+	"\tsnapshots\x18\x01 \x03(\v2\x0e.memo.SnapshotR\tsnapshots\"T\n" +
+	"\x16RestoreSnapshotRequest\x12\x1f\n" +
+	"\vsnapshot_id\x18\x01 \x01(\tR\n" +
+	"snapshotId\x12\x19\n" +
+	"\bmemo_ids\x18\x02 \x03(\tR\amemoIds\"h\n" +
+	"\x17RestoreSnapshotResponse\x12%\n" +
+	"\x0erestored_paths\x18\x01 \x03(\tR\rrestoredPaths\x12&\n" +
+	"\x06backup\x18\x02 \x01(\v2\x0e.memo.SnapshotR\x06backup\"\xe4\x02\n" +
+	"\x06EditOp\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\tR\x06memoId\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x03R\brevision\x12\x1b\n" +
+	"\tclient_id\x18\x03 \x01(\tR\bclientId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12$\n" +
+	"\x04join\x18\x05 \x01(\v2\x0e.memo.EditJoinH\x00R\x04join\x120\n" +
+	"\bsnapshot\x18\x06 \x01(\v2\x12.memo.EditSnapshotH\x00R\bsnapshot\x123\n" +
+	"\toperation\x18\a \x01(\v2\x13.memo.TextOperationH\x00R\toperation\x12*\n" +
+	"\x06cursor\x18\b \x01(\v2\x10.memo.EditCursorH\x00R\x06cursor\x120\n" +
+	"\bpresence\x18\t \x01(\v2\x12.memo.EditPresenceH\x00R\bpresenceB\t\n" +
+	"\apayload\"\x1e\n" +
+	"\bEditJoin\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"c\n" +
+	"\fEditSnapshot\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x129\n" +
+	"\fparticipants\x18\x02 \x03(\v2\x15.memo.EditParticipantR\fparticipants\"l\n" +
+	"\x0fEditParticipant\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12(\n" +
+	"\x06cursor\x18\x03 \x01(\v2\x10.memo.EditCursorR\x06cursor\"D\n" +
+	"\rTextOperation\x123\n" +
+	"\n" +
+	"components\x18\x01 \x03(\v2\x13.memo.TextComponentR\n" +
+	"components\"j\n" +
+	"\rTextComponent\x12\x18\n" +
+	"\x06retain\x18\x01 \x01(\x05H\x00R\x06retain\x12\x18\n" +
+	"\x06insert\x18\x02 \x01(\tH\x00R\x06insert\x12\x18\n" +
+	"\x06delete\x18\x03 \x01(\x05H\x00R\x06deleteB\v\n" +
+	"\tcomponent\"M\n" +
+	"\n" +
+	"EditCursor\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\x05R\bposition\x12#\n" +
+	"\rselection_end\x18\x02 \x01(\x05R\fselectionEnd\"&\n" +
+	"\fEditPresence\x12\x16\n" +
+	"\x06online\x18\x01 \x01(\bR\x06online\"\xbb\x01\n" +
+	"\bReminder\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\tR\x06memoId\x127\n" +
+	"\tremind_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bremindAt\x12\x1e\n" +
+	"\x04memo\x18\x03 \x01(\v2\n" +
+	".memo.MemoR\x04memo\x12=\n" +
+	"\fdelivered_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAt\"\x18\n" +
+	"\x16StreamRemindersRequest\"\xad\x01\n" +
+	"\x15SnoozeReminderRequest\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\tR\x06memoId\x129\n" +
+	"\tremind_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\bremindAt\x127\n" +
+	"\bduration\x18\x03 \x01(\v2\x19.google.protobuf.DurationH\x00R\bdurationB\a\n" +
+	"\x05until\"D\n" +
+	"\x16SnoozeReminderResponse\x12*\n" +
+	"\breminder\x18\x01 \x01(\v2\x0e.memo.ReminderR\breminder\"1\n" +
+	"\x16DismissReminderRequest\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\tR\x06memoId\"\x19\n" +
+	"\x17DismissReminderResponse\"\xc2\x01\n" +
+	"\fMemoTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x1a\n" +
+	"\x18ListMemoTemplatesRequest\"M\n" +
+	"\x19ListMemoTemplatesResponse\x120\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x12.memo.MemoTemplateR\ttemplates\"I\n" +
+	"\x19CreateMemoTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"L\n" +
+	"\x1aCreateMemoTemplateResponse\x12.\n" +
+	"\btemplate\x18\x01 \x01(\v2\x12.memo.MemoTemplateR\btemplate\"+\n" +
+	"\x19DeleteMemoTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1c\n" +
+	"\x1aDeleteMemoTemplateResponse\"\x11\n" +
+	"\x0fGetUsageRequest\"~\n" +
+	"\vQuotaLimits\x12*\n" +
+	"\x11max_content_bytes\x18\x01 \x01(\x03R\x0fmaxContentBytes\x12\x1b\n" +
+	"\tmax_memos\x18\x02 \x01(\x03R\bmaxMemos\x12&\n" +
+	"\x0fmax_total_bytes\x18\x03 \x01(\x03R\rmaxTotalBytes\"\x93\x01\n" +
+	"\x10GetUsageResponse\x12\x14\n" +
+	"\x05scope\x18\x01 \x01(\tR\x05scope\x12\x1d\n" +
+	"\n" +
+	"memo_count\x18\x02 \x01(\x03R\tmemoCount\x12\x1f\n" +
+	"\vtotal_bytes\x18\x03 \x01(\x03R\n" +
+	"totalBytes\x12)\n" +
+	"\x06limits\x18\x04 \x01(\v2\x11.memo.QuotaLimitsR\x06limits*b\n" +
+	"\rArchiveFormat\x12\x1e\n" +
+	"\x1aARCHIVE_FORMAT_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ARCHIVE_FORMAT_TAR_GZ\x10\x01\x12\x16\n" +
+	"\x12ARCHIVE_FORMAT_ZIP\x10\x02*\x86\x01\n" +
+	"\x0eConflictPolicy\x12\x1f\n" +
+	"\x1bCONFLICT_POLICY_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14CONFLICT_POLICY_SKIP\x10\x01\x12\x1d\n" +
+	"\x19CONFLICT_POLICY_OVERWRITE\x10\x02\x12\x1a\n" +
+	"\x16CONFLICT_POLICY_RENAME\x10\x03*\xb7\x01\n" +
+	"\fImportStatus\x12\x1d\n" +
+	"\x19IMPORT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15IMPORT_STATUS_CREATED\x10\x01\x12\x19\n" +
+	"\x15IMPORT_STATUS_SKIPPED\x10\x02\x12\x1d\n" +
+	"\x19IMPORT_STATUS_OVERWRITTEN\x10\x03\x12\x19\n" +
+	"\x15IMPORT_STATUS_RENAMED\x10\x04\x12\x18\n" +
+	"\x14IMPORT_STATUS_FAILED\x10\x05*\x86\x02\n" +
+	"\x12IntegrityIssueKind\x12$\n" +
+	" INTEGRITY_ISSUE_KIND_UNSPECIFIED\x10\x00\x12'\n" +
+	"#INTEGRITY_ISSUE_KIND_MALFORMED_NAME\x10\x01\x12%\n" +
+	"!INTEGRITY_ISSUE_KIND_DUPLICATE_ID\x10\x02\x12(\n" +
+	"$INTEGRITY_ISSUE_KIND_UNREADABLE_JSON\x10\x03\x12(\n" +
+	"$INTEGRITY_ISSUE_KIND_UNREADABLE_FILE\x10\x04\x12&\n" +
+	"\"INTEGRITY_ISSUE_KIND_ORPHANED_FILE\x10\x05*\xac\x01\n" +
+	"\x0fIntegrityAction\x12 \n" +
+	"\x1cINTEGRITY_ACTION_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15INTEGRITY_ACTION_NONE\x10\x01\x12\x1d\n" +
+	"\x19INTEGRITY_ACTION_REPAIRED\x10\x02\x12 \n" +
+	"\x1cINTEGRITY_ACTION_QUARANTINED\x10\x03\x12\x1b\n" +
+	"\x17INTEGRITY_ACTION_FAILED\x10\x042\xee\r\n" +
+	"\vMemoService\x12?\n" +
+	"\n" +
+	"CreateMemo\x12\x17.memo.CreateMemoRequest\x1a\x18.memo.CreateMemoResponse\x12Q\n" +
+	"\x10CreateMemoByJson\x12\x1d.memo.CreateMemoByJsonRequest\x1a\x1e.memo.CreateMemoByJsonResponse\x126\n" +
+	"\aGetMemo\x12\x14.memo.GetMemoRequest\x1a\x15.memo.GetMemoResponse\x12F\n" +
+	"\rGetMultiMemos\x12\x19.memo.GetMultiMemoRequest\x1a\x1a.memo.GetMultiMemoResponse\x12<\n" +
+	"\tListMemos\x12\x16.memo.ListMemosRequest\x1a\x17.memo.ListMemosResponse\x12?\n" +
+	"\n" +
+	"UpdateMemo\x12\x17.memo.UpdateMemoRequest\x1a\x18.memo.UpdateMemoResponse\x128\n" +
+	"\vExportMemos\x12\x13.memo.ExportRequest\x1a\x12.memo.ArchiveChunk0\x01\x12>\n" +
+	"\vImportMemos\x12\x12.memo.ArchiveChunk\x1a\x19.memo.ImportMemosResponse(\x01\x12E\n" +
+	"\fListMemoTags\x12\x19.memo.ListMemoTagsRequest\x1a\x1a.memo.ListMemoTagsResponse\x12E\n" +
+	"\fGetMemoLinks\x12\x19.memo.GetMemoLinksRequest\x1a\x1a.memo.GetMemoLinksResponse\x12E\n" +
+	"\fGetMemoGraph\x12\x19.memo.GetMemoGraphRequest\x1a\x1a.memo.GetMemoGraphResponse\x12K\n" +
+	"\x0eGetMemoHistory\x12\x1b.memo.GetMemoHistoryRequest\x1a\x1c.memo.GetMemoHistoryResponse\x12?\n" +
+	"\n" +
+	"RevertMemo\x12\x17.memo.RevertMemoRequest\x1a\x18.memo.RevertMemoResponse\x12K\n" +
+	"\x0eCreateNotebook\x12\x1b.memo.CreateNotebookRequest\x1a\x1c.memo.CreateNotebookResponse\x12H\n" +
+	"\rListNotebooks\x12\x1a.memo.ListNotebooksRequest\x1a\x1b.memo.ListNotebooksResponse\x129\n" +
+	"\bMoveMemo\x12\x15.memo.MoveMemoRequest\x1a\x16.memo.MoveMemoResponse\x12K\n" +
+	"\x0eDeleteNotebook\x12\x1b.memo.DeleteNotebookRequest\x1a\x1c.memo.DeleteNotebookResponse\x12-\n" +
+	"\vEditSession\x12\f.memo.EditOp\x1a\f.memo.EditOp(\x010\x01\x12A\n" +
+	"\x0fStreamReminders\x12\x1c.memo.StreamRemindersRequest\x1a\x0e.memo.Reminder0\x01\x12K\n" +
+	"\x0eSnoozeReminder\x12\x1b.memo.SnoozeReminderRequest\x1a\x1c.memo.SnoozeReminderResponse\x12N\n" +
+	"\x0fDismissReminder\x12\x1c.memo.DismissReminderRequest\x1a\x1d.memo.DismissReminderResponse\x12T\n" +
+	"\x11ListMemoTemplates\x12\x1e.memo.ListMemoTemplatesRequest\x1a\x1f.memo.ListMemoTemplatesResponse\x12W\n" +
+	"\x12CreateMemoTemplate\x12\x1f.memo.CreateMemoTemplateRequest\x1a .memo.CreateMemoTemplateResponse\x12W\n" +
+	"\x12DeleteMemoTemplate\x12\x1f.memo.DeleteMemoTemplateRequest\x1a .memo.DeleteMemoTemplateResponse\x129\n" +
+	"\bGetUsage\x12\x15.memo.GetUsageRequest\x1a\x16.memo.GetUsageResponse2\xc6\x02\n" +
+	"\x10MemoAdminService\x12K\n" +
+	"\x0eCheckIntegrity\x12\x1b.memo.CheckIntegrityRequest\x1a\x1c.memo.CheckIntegrityResponse\x12K\n" +
+	"\x0eCreateSnapshot\x12\x1b.memo.CreateSnapshotRequest\x1a\x1c.memo.CreateSnapshotResponse\x12H\n" +
+	"\rListSnapshots\x12\x1a.memo.ListSnapshotsRequest\x1a\x1b.memo.ListSnapshotsResponse\x12N\n" +
+	"\x0fRestoreSnapshot\x12\x1c.memo.RestoreSnapshotRequest\x1a\x1d.memo.RestoreSnapshotResponseB\n" +
+	"Z\bapp/grpcb\x06proto3"
 
 var (
 	file_proto_api_memo_proto_rawDescOnce sync.Once
@@ -731,51 +4699,218 @@ func file_proto_api_memo_proto_rawDescGZIP() []byte {
 	return file_proto_api_memo_proto_rawDescData
 }
 
-var file_proto_api_memo_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_proto_api_memo_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_proto_api_memo_proto_msgTypes = make([]protoimpl.MessageInfo, 77)
 var file_proto_api_memo_proto_goTypes = []any{
-	(*Memo)(nil),                     // 0: memo.Memo
-	(*CreateMemoRequest)(nil),        // 1: memo.CreateMemoRequest
-	(*CreateMemoResponse)(nil),       // 2: memo.CreateMemoResponse
-	(*CreateMemoByJsonRequest)(nil),  // 3: memo.CreateMemoByJsonRequest
-	(*CreateMemoByJsonResponse)(nil), // 4: memo.CreateMemoByJsonResponse
-	(*GetMemoRequest)(nil),           // 5: memo.GetMemoRequest
-	(*GetMemoResponse)(nil),          // 6: memo.GetMemoResponse
-	(*GetMultiMemoRequest)(nil),      // 7: memo.GetMultiMemoRequest
-	(*GetMultiMemoResponse)(nil),     // 8: memo.GetMultiMemoResponse
-	(*ListMemosRequest)(nil),         // 9: memo.ListMemosRequest
-	(*ListMemosResponse)(nil),        // 10: memo.ListMemosResponse
-	(*UpdateMemoRequest)(nil),        // 11: memo.UpdateMemoRequest
-	(*UpdateMemoResponse)(nil),       // 12: memo.UpdateMemoResponse
-	(*timestamppb.Timestamp)(nil),    // 13: google.protobuf.Timestamp
+	(ArchiveFormat)(0),                 // 0: memo.ArchiveFormat
+	(ConflictPolicy)(0),                // 1: memo.ConflictPolicy
+	(ImportStatus)(0),                  // 2: memo.ImportStatus
+	(IntegrityIssueKind)(0),            // 3: memo.IntegrityIssueKind
+	(IntegrityAction)(0),               // 4: memo.IntegrityAction
+	(*Memo)(nil),                       // 5: memo.Memo
+	(*CreateMemoRequest)(nil),          // 6: memo.CreateMemoRequest
+	(*CreateMemoResponse)(nil),         // 7: memo.CreateMemoResponse
+	(*CreateMemoByJsonRequest)(nil),    // 8: memo.CreateMemoByJsonRequest
+	(*CreateMemoByJsonResponse)(nil),   // 9: memo.CreateMemoByJsonResponse
+	(*GetMemoRequest)(nil),             // 10: memo.GetMemoRequest
+	(*GetMemoResponse)(nil),            // 11: memo.GetMemoResponse
+	(*GetMultiMemoRequest)(nil),        // 12: memo.GetMultiMemoRequest
+	(*GetMultiMemoResponse)(nil),       // 13: memo.GetMultiMemoResponse
+	(*ListMemosRequest)(nil),           // 14: memo.ListMemosRequest
+	(*ListMemosResponse)(nil),          // 15: memo.ListMemosResponse
+	(*UpdateMemoRequest)(nil),          // 16: memo.UpdateMemoRequest
+	(*UpdateMemoResponse)(nil),         // 17: memo.UpdateMemoResponse
+	(*ExportRequest)(nil),              // 18: memo.ExportRequest
+	(*ArchiveChunk)(nil),               // 19: memo.ArchiveChunk
+	(*ImportResult)(nil),               // 20: memo.ImportResult
+	(*ImportMemosResponse)(nil),        // 21: memo.ImportMemosResponse
+	(*ListMemoTagsRequest)(nil),        // 22: memo.ListMemoTagsRequest
+	(*TagCount)(nil),                   // 23: memo.TagCount
+	(*ListMemoTagsResponse)(nil),       // 24: memo.ListMemoTagsResponse
+	(*MemoRef)(nil),                    // 25: memo.MemoRef
+	(*GetMemoLinksRequest)(nil),        // 26: memo.GetMemoLinksRequest
+	(*GetMemoLinksResponse)(nil),       // 27: memo.GetMemoLinksResponse
+	(*GetMemoGraphRequest)(nil),        // 28: memo.GetMemoGraphRequest
+	(*MemoEdge)(nil),                   // 29: memo.MemoEdge
+	(*GetMemoGraphResponse)(nil),       // 30: memo.GetMemoGraphResponse
+	(*MemoCommit)(nil),                 // 31: memo.MemoCommit
+	(*GetMemoHistoryRequest)(nil),      // 32: memo.GetMemoHistoryRequest
+	(*GetMemoHistoryResponse)(nil),     // 33: memo.GetMemoHistoryResponse
+	(*RevertMemoRequest)(nil),          // 34: memo.RevertMemoRequest
+	(*RevertMemoResponse)(nil),         // 35: memo.RevertMemoResponse
+	(*Notebook)(nil),                   // 36: memo.Notebook
+	(*CreateNotebookRequest)(nil),      // 37: memo.CreateNotebookRequest
+	(*CreateNotebookResponse)(nil),     // 38: memo.CreateNotebookResponse
+	(*ListNotebooksRequest)(nil),       // 39: memo.ListNotebooksRequest
+	(*ListNotebooksResponse)(nil),      // 40: memo.ListNotebooksResponse
+	(*MoveMemoRequest)(nil),            // 41: memo.MoveMemoRequest
+	(*MoveMemoResponse)(nil),           // 42: memo.MoveMemoResponse
+	(*DeleteNotebookRequest)(nil),      // 43: memo.DeleteNotebookRequest
+	(*DeleteNotebookResponse)(nil),     // 44: memo.DeleteNotebookResponse
+	(*CheckIntegrityRequest)(nil),      // 45: memo.CheckIntegrityRequest
+	(*IntegrityIssue)(nil),             // 46: memo.IntegrityIssue
+	(*CheckIntegrityResponse)(nil),     // 47: memo.CheckIntegrityResponse
+	(*Snapshot)(nil),                   // 48: memo.Snapshot
+	(*CreateSnapshotRequest)(nil),      // 49: memo.CreateSnapshotRequest
+	(*CreateSnapshotResponse)(nil),     // 50: memo.CreateSnapshotResponse
+	(*ListSnapshotsRequest)(nil),       // 51: memo.ListSnapshotsRequest
+	(*ListSnapshotsResponse)(nil),      // 52: memo.ListSnapshotsResponse
+	(*RestoreSnapshotRequest)(nil),     // 53: memo.RestoreSnapshotRequest
+	(*RestoreSnapshotResponse)(nil),    // 54: memo.RestoreSnapshotResponse
+	(*EditOp)(nil),                     // 55: memo.EditOp
+	(*EditJoin)(nil),                   // 56: memo.EditJoin
+	(*EditSnapshot)(nil),               // 57: memo.EditSnapshot
+	(*EditParticipant)(nil),            // 58: memo.EditParticipant
+	(*TextOperation)(nil),              // 59: memo.TextOperation
+	(*TextComponent)(nil),              // 60: memo.TextComponent
+	(*EditCursor)(nil),                 // 61: memo.EditCursor
+	(*EditPresence)(nil),               // 62: memo.EditPresence
+	(*Reminder)(nil),                   // 63: memo.Reminder
+	(*StreamRemindersRequest)(nil),     // 64: memo.StreamRemindersRequest
+	(*SnoozeReminderRequest)(nil),      // 65: memo.SnoozeReminderRequest
+	(*SnoozeReminderResponse)(nil),     // 66: memo.SnoozeReminderResponse
+	(*DismissReminderRequest)(nil),     // 67: memo.DismissReminderRequest
+	(*DismissReminderResponse)(nil),    // 68: memo.DismissReminderResponse
+	(*MemoTemplate)(nil),               // 69: memo.MemoTemplate
+	(*ListMemoTemplatesRequest)(nil),   // 70: memo.ListMemoTemplatesRequest
+	(*ListMemoTemplatesResponse)(nil),  // 71: memo.ListMemoTemplatesResponse
+	(*CreateMemoTemplateRequest)(nil),  // 72: memo.CreateMemoTemplateRequest
+	(*CreateMemoTemplateResponse)(nil), // 73: memo.CreateMemoTemplateResponse
+	(*DeleteMemoTemplateRequest)(nil),  // 74: memo.DeleteMemoTemplateRequest
+	(*DeleteMemoTemplateResponse)(nil), // 75: memo.DeleteMemoTemplateResponse
+	(*GetUsageRequest)(nil),            // 76: memo.GetUsageRequest
+	(*QuotaLimits)(nil),                // 77: memo.QuotaLimits
+	(*GetUsageResponse)(nil),           // 78: memo.GetUsageResponse
+	nil,                                // 79: memo.Memo.PropertiesEntry
+	nil,                                // 80: memo.CreateMemoRequest.VariablesEntry
+	nil,                                // 81: memo.ListMemosRequest.PropertiesEntry
+	(*timestamppb.Timestamp)(nil),      // 82: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),        // 83: google.protobuf.Duration
 }
 var file_proto_api_memo_proto_depIdxs = []int32{
-	13, // 0: memo.Memo.created_at:type_name -> google.protobuf.Timestamp
-	13, // 1: memo.Memo.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: memo.CreateMemoResponse.memo:type_name -> memo.Memo
-	0,  // 3: memo.CreateMemoByJsonResponse.memo:type_name -> memo.Memo
-	0,  // 4: memo.GetMemoResponse.memo:type_name -> memo.Memo
-	0,  // 5: memo.GetMultiMemoResponse.memo:type_name -> memo.Memo
-	13, // 6: memo.ListMemosRequest.start_time:type_name -> google.protobuf.Timestamp
-	13, // 7: memo.ListMemosRequest.end_time:type_name -> google.protobuf.Timestamp
-	0,  // 8: memo.ListMemosResponse.memos:type_name -> memo.Memo
-	0,  // 9: memo.UpdateMemoResponse.memo:type_name -> memo.Memo
-	1,  // 10: memo.MemoService.CreateMemo:input_type -> memo.CreateMemoRequest
-	3,  // 11: memo.MemoService.CreateMemoByJson:input_type -> memo.CreateMemoByJsonRequest
-	5,  // 12: memo.MemoService.GetMemo:input_type -> memo.GetMemoRequest
-	7,  // 13: memo.MemoService.GetMultiMemos:input_type -> memo.GetMultiMemoRequest
-	9,  // 14: memo.MemoService.ListMemos:input_type -> memo.ListMemosRequest
-	11, // 15: memo.MemoService.UpdateMemo:input_type -> memo.UpdateMemoRequest
-	2,  // 16: memo.MemoService.CreateMemo:output_type -> memo.CreateMemoResponse
-	4,  // 17: memo.MemoService.CreateMemoByJson:output_type -> memo.CreateMemoByJsonResponse
-	6,  // 18: memo.MemoService.GetMemo:output_type -> memo.GetMemoResponse
-	8,  // 19: memo.MemoService.GetMultiMemos:output_type -> memo.GetMultiMemoResponse
-	10, // 20: memo.MemoService.ListMemos:output_type -> memo.ListMemosResponse
-	12, // 21: memo.MemoService.UpdateMemo:output_type -> memo.UpdateMemoResponse
-	16, // [16:22] is the sub-list for method output_type
-	10, // [10:16] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	82, // 0: memo.Memo.created_at:type_name -> google.protobuf.Timestamp
+	82, // 1: memo.Memo.updated_at:type_name -> google.protobuf.Timestamp
+	79, // 2: memo.Memo.properties:type_name -> memo.Memo.PropertiesEntry
+	82, // 3: memo.Memo.remind_at:type_name -> google.protobuf.Timestamp
+	82, // 4: memo.CreateMemoRequest.remind_at:type_name -> google.protobuf.Timestamp
+	80, // 5: memo.CreateMemoRequest.variables:type_name -> memo.CreateMemoRequest.VariablesEntry
+	5,  // 6: memo.CreateMemoResponse.memo:type_name -> memo.Memo
+	5,  // 7: memo.CreateMemoByJsonResponse.memo:type_name -> memo.Memo
+	5,  // 8: memo.GetMemoResponse.memo:type_name -> memo.Memo
+	5,  // 9: memo.GetMultiMemoResponse.memo:type_name -> memo.Memo
+	5,  // 10: memo.GetMultiMemoResponse.memos:type_name -> memo.Memo
+	82, // 11: memo.ListMemosRequest.start_time:type_name -> google.protobuf.Timestamp
+	82, // 12: memo.ListMemosRequest.end_time:type_name -> google.protobuf.Timestamp
+	81, // 13: memo.ListMemosRequest.properties:type_name -> memo.ListMemosRequest.PropertiesEntry
+	5,  // 14: memo.ListMemosResponse.memos:type_name -> memo.Memo
+	82, // 15: memo.UpdateMemoRequest.remind_at:type_name -> google.protobuf.Timestamp
+	5,  // 16: memo.UpdateMemoResponse.memo:type_name -> memo.Memo
+	0,  // 17: memo.ExportRequest.format:type_name -> memo.ArchiveFormat
+	0,  // 18: memo.ArchiveChunk.format:type_name -> memo.ArchiveFormat
+	1,  // 19: memo.ArchiveChunk.conflict_policy:type_name -> memo.ConflictPolicy
+	2,  // 20: memo.ImportResult.status:type_name -> memo.ImportStatus
+	20, // 21: memo.ImportMemosResponse.results:type_name -> memo.ImportResult
+	23, // 22: memo.ListMemoTagsResponse.tags:type_name -> memo.TagCount
+	25, // 23: memo.GetMemoLinksResponse.outgoing:type_name -> memo.MemoRef
+	25, // 24: memo.GetMemoLinksResponse.backlinks:type_name -> memo.MemoRef
+	25, // 25: memo.GetMemoGraphResponse.nodes:type_name -> memo.MemoRef
+	29, // 26: memo.GetMemoGraphResponse.edges:type_name -> memo.MemoEdge
+	82, // 27: memo.MemoCommit.committed_at:type_name -> google.protobuf.Timestamp
+	31, // 28: memo.GetMemoHistoryResponse.commits:type_name -> memo.MemoCommit
+	5,  // 29: memo.RevertMemoResponse.memo:type_name -> memo.Memo
+	31, // 30: memo.RevertMemoResponse.commit:type_name -> memo.MemoCommit
+	36, // 31: memo.CreateNotebookResponse.notebook:type_name -> memo.Notebook
+	36, // 32: memo.ListNotebooksResponse.notebooks:type_name -> memo.Notebook
+	5,  // 33: memo.MoveMemoResponse.memo:type_name -> memo.Memo
+	3,  // 34: memo.IntegrityIssue.kind:type_name -> memo.IntegrityIssueKind
+	4,  // 35: memo.IntegrityIssue.action:type_name -> memo.IntegrityAction
+	46, // 36: memo.CheckIntegrityResponse.issues:type_name -> memo.IntegrityIssue
+	82, // 37: memo.Snapshot.created_at:type_name -> google.protobuf.Timestamp
+	48, // 38: memo.CreateSnapshotResponse.snapshot:type_name -> memo.Snapshot
+	48, // 39: memo.ListSnapshotsResponse.snapshots:type_name -> memo.Snapshot
+	48, // 40: memo.RestoreSnapshotResponse.backup:type_name -> memo.Snapshot
+	56, // 41: memo.EditOp.join:type_name -> memo.EditJoin
+	57, // 42: memo.EditOp.snapshot:type_name -> memo.EditSnapshot
+	59, // 43: memo.EditOp.operation:type_name -> memo.TextOperation
+	61, // 44: memo.EditOp.cursor:type_name -> memo.EditCursor
+	62, // 45: memo.EditOp.presence:type_name -> memo.EditPresence
+	58, // 46: memo.EditSnapshot.participants:type_name -> memo.EditParticipant
+	61, // 47: memo.EditParticipant.cursor:type_name -> memo.EditCursor
+	60, // 48: memo.TextOperation.components:type_name -> memo.TextComponent
+	82, // 49: memo.Reminder.remind_at:type_name -> google.protobuf.Timestamp
+	5,  // 50: memo.Reminder.memo:type_name -> memo.Memo
+	82, // 51: memo.Reminder.delivered_at:type_name -> google.protobuf.Timestamp
+	82, // 52: memo.SnoozeReminderRequest.remind_at:type_name -> google.protobuf.Timestamp
+	83, // 53: memo.SnoozeReminderRequest.duration:type_name -> google.protobuf.Duration
+	63, // 54: memo.SnoozeReminderResponse.reminder:type_name -> memo.Reminder
+	82, // 55: memo.MemoTemplate.created_at:type_name -> google.protobuf.Timestamp
+	82, // 56: memo.MemoTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	69, // 57: memo.ListMemoTemplatesResponse.templates:type_name -> memo.MemoTemplate
+	69, // 58: memo.CreateMemoTemplateResponse.template:type_name -> memo.MemoTemplate
+	77, // 59: memo.GetUsageResponse.limits:type_name -> memo.QuotaLimits
+	6,  // 60: memo.MemoService.CreateMemo:input_type -> memo.CreateMemoRequest
+	8,  // 61: memo.MemoService.CreateMemoByJson:input_type -> memo.CreateMemoByJsonRequest
+	10, // 62: memo.MemoService.GetMemo:input_type -> memo.GetMemoRequest
+	12, // 63: memo.MemoService.GetMultiMemos:input_type -> memo.GetMultiMemoRequest
+	14, // 64: memo.MemoService.ListMemos:input_type -> memo.ListMemosRequest
+	16, // 65: memo.MemoService.UpdateMemo:input_type -> memo.UpdateMemoRequest
+	18, // 66: memo.MemoService.ExportMemos:input_type -> memo.ExportRequest
+	19, // 67: memo.MemoService.ImportMemos:input_type -> memo.ArchiveChunk
+	22, // 68: memo.MemoService.ListMemoTags:input_type -> memo.ListMemoTagsRequest
+	26, // 69: memo.MemoService.GetMemoLinks:input_type -> memo.GetMemoLinksRequest
+	28, // 70: memo.MemoService.GetMemoGraph:input_type -> memo.GetMemoGraphRequest
+	32, // 71: memo.MemoService.GetMemoHistory:input_type -> memo.GetMemoHistoryRequest
+	34, // 72: memo.MemoService.RevertMemo:input_type -> memo.RevertMemoRequest
+	37, // 73: memo.MemoService.CreateNotebook:input_type -> memo.CreateNotebookRequest
+	39, // 74: memo.MemoService.ListNotebooks:input_type -> memo.ListNotebooksRequest
+	41, // 75: memo.MemoService.MoveMemo:input_type -> memo.MoveMemoRequest
+	43, // 76: memo.MemoService.DeleteNotebook:input_type -> memo.DeleteNotebookRequest
+	55, // 77: memo.MemoService.EditSession:input_type -> memo.EditOp
+	64, // 78: memo.MemoService.StreamReminders:input_type -> memo.StreamRemindersRequest
+	65, // 79: memo.MemoService.SnoozeReminder:input_type -> memo.SnoozeReminderRequest
+	67, // 80: memo.MemoService.DismissReminder:input_type -> memo.DismissReminderRequest
+	70, // 81: memo.MemoService.ListMemoTemplates:input_type -> memo.ListMemoTemplatesRequest
+	72, // 82: memo.MemoService.CreateMemoTemplate:input_type -> memo.CreateMemoTemplateRequest
+	74, // 83: memo.MemoService.DeleteMemoTemplate:input_type -> memo.DeleteMemoTemplateRequest
+	76, // 84: memo.MemoService.GetUsage:input_type -> memo.GetUsageRequest
+	45, // 85: memo.MemoAdminService.CheckIntegrity:input_type -> memo.CheckIntegrityRequest
+	49, // 86: memo.MemoAdminService.CreateSnapshot:input_type -> memo.CreateSnapshotRequest
+	51, // 87: memo.MemoAdminService.ListSnapshots:input_type -> memo.ListSnapshotsRequest
+	53, // 88: memo.MemoAdminService.RestoreSnapshot:input_type -> memo.RestoreSnapshotRequest
+	7,  // 89: memo.MemoService.CreateMemo:output_type -> memo.CreateMemoResponse
+	9,  // 90: memo.MemoService.CreateMemoByJson:output_type -> memo.CreateMemoByJsonResponse
+	11, // 91: memo.MemoService.GetMemo:output_type -> memo.GetMemoResponse
+	13, // 92: memo.MemoService.GetMultiMemos:output_type -> memo.GetMultiMemoResponse
+	15, // 93: memo.MemoService.ListMemos:output_type -> memo.ListMemosResponse
+	17, // 94: memo.MemoService.UpdateMemo:output_type -> memo.UpdateMemoResponse
+	19, // 95: memo.MemoService.ExportMemos:output_type -> memo.ArchiveChunk
+	21, // 96: memo.MemoService.ImportMemos:output_type -> memo.ImportMemosResponse
+	24, // 97: memo.MemoService.ListMemoTags:output_type -> memo.ListMemoTagsResponse
+	27, // 98: memo.MemoService.GetMemoLinks:output_type -> memo.GetMemoLinksResponse
+	30, // 99: memo.MemoService.GetMemoGraph:output_type -> memo.GetMemoGraphResponse
+	33, // 100: memo.MemoService.GetMemoHistory:output_type -> memo.GetMemoHistoryResponse
+	35, // 101: memo.MemoService.RevertMemo:output_type -> memo.RevertMemoResponse
+	38, // 102: memo.MemoService.CreateNotebook:output_type -> memo.CreateNotebookResponse
+	40, // 103: memo.MemoService.ListNotebooks:output_type -> memo.ListNotebooksResponse
+	42, // 104: memo.MemoService.MoveMemo:output_type -> memo.MoveMemoResponse
+	44, // 105: memo.MemoService.DeleteNotebook:output_type -> memo.DeleteNotebookResponse
+	55, // 106: memo.MemoService.EditSession:output_type -> memo.EditOp
+	63, // 107: memo.MemoService.StreamReminders:output_type -> memo.Reminder
+	66, // 108: memo.MemoService.SnoozeReminder:output_type -> memo.SnoozeReminderResponse
+	68, // 109: memo.MemoService.DismissReminder:output_type -> memo.DismissReminderResponse
+	71, // 110: memo.MemoService.ListMemoTemplates:output_type -> memo.ListMemoTemplatesResponse
+	73, // 111: memo.MemoService.CreateMemoTemplate:output_type -> memo.CreateMemoTemplateResponse
+	75, // 112: memo.MemoService.DeleteMemoTemplate:output_type -> memo.DeleteMemoTemplateResponse
+	78, // 113: memo.MemoService.GetUsage:output_type -> memo.GetUsageResponse
+	47, // 114: memo.MemoAdminService.CheckIntegrity:output_type -> memo.CheckIntegrityResponse
+	50, // 115: memo.MemoAdminService.CreateSnapshot:output_type -> memo.CreateSnapshotResponse
+	52, // 116: memo.MemoAdminService.ListSnapshots:output_type -> memo.ListSnapshotsResponse
+	54, // 117: memo.MemoAdminService.RestoreSnapshot:output_type -> memo.RestoreSnapshotResponse
+	89, // [89:118] is the sub-list for method output_type
+	60, // [60:89] is the sub-list for method input_type
+	60, // [60:60] is the sub-list for extension type_name
+	60, // [60:60] is the sub-list for extension extendee
+	0,  // [0:60] is the sub-list for field type_name
 }
 
 func init() { file_proto_api_memo_proto_init() }
@@ -784,18 +4919,36 @@ func file_proto_api_memo_proto_init() {
 		return
 	}
 	file_proto_api_memo_proto_msgTypes[9].OneofWrappers = []any{}
+	file_proto_api_memo_proto_msgTypes[11].OneofWrappers = []any{}
+	file_proto_api_memo_proto_msgTypes[50].OneofWrappers = []any{
+		(*EditOp_Join)(nil),
+		(*EditOp_Snapshot)(nil),
+		(*EditOp_Operation)(nil),
+		(*EditOp_Cursor)(nil),
+		(*EditOp_Presence)(nil),
+	}
+	file_proto_api_memo_proto_msgTypes[55].OneofWrappers = []any{
+		(*TextComponent_Retain)(nil),
+		(*TextComponent_Insert)(nil),
+		(*TextComponent_Delete)(nil),
+	}
+	file_proto_api_memo_proto_msgTypes[60].OneofWrappers = []any{
+		(*SnoozeReminderRequest_RemindAt)(nil),
+		(*SnoozeReminderRequest_Duration)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_api_memo_proto_rawDesc), len(file_proto_api_memo_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      5,
+			NumMessages:   77,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_proto_api_memo_proto_goTypes,
 		DependencyIndexes: file_proto_api_memo_proto_depIdxs,
+		EnumInfos:         file_proto_api_memo_proto_enumTypes,
 		MessageInfos:      file_proto_api_memo_proto_msgTypes,
 	}.Build()
 	File_proto_api_memo_proto = out.File
@@ -19,12 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MemoService_CreateMemo_FullMethodName       = "/memo.MemoService/CreateMemo"
-	MemoService_CreateMemoByJson_FullMethodName = "/memo.MemoService/CreateMemoByJson"
-	MemoService_GetMemo_FullMethodName          = "/memo.MemoService/GetMemo"
-	MemoService_GetMultiMemos_FullMethodName    = "/memo.MemoService/GetMultiMemos"
-	MemoService_ListMemos_FullMethodName        = "/memo.MemoService/ListMemos"
-	MemoService_UpdateMemo_FullMethodName       = "/memo.MemoService/UpdateMemo"
+	MemoService_CreateMemo_FullMethodName         = "/memo.MemoService/CreateMemo"
+	MemoService_CreateMemoByJson_FullMethodName   = "/memo.MemoService/CreateMemoByJson"
+	MemoService_GetMemo_FullMethodName            = "/memo.MemoService/GetMemo"
+	MemoService_GetMultiMemos_FullMethodName      = "/memo.MemoService/GetMultiMemos"
+	MemoService_ListMemos_FullMethodName          = "/memo.MemoService/ListMemos"
+	MemoService_UpdateMemo_FullMethodName         = "/memo.MemoService/UpdateMemo"
+	MemoService_ExportMemos_FullMethodName        = "/memo.MemoService/ExportMemos"
+	MemoService_ImportMemos_FullMethodName        = "/memo.MemoService/ImportMemos"
+	MemoService_ListMemoTags_FullMethodName       = "/memo.MemoService/ListMemoTags"
+	MemoService_GetMemoLinks_FullMethodName       = "/memo.MemoService/GetMemoLinks"
+	MemoService_GetMemoGraph_FullMethodName       = "/memo.MemoService/GetMemoGraph"
+	MemoService_GetMemoHistory_FullMethodName     = "/memo.MemoService/GetMemoHistory"
+	MemoService_RevertMemo_FullMethodName         = "/memo.MemoService/RevertMemo"
+	MemoService_CreateNotebook_FullMethodName     = "/memo.MemoService/CreateNotebook"
+	MemoService_ListNotebooks_FullMethodName      = "/memo.MemoService/ListNotebooks"
+	MemoService_MoveMemo_FullMethodName           = "/memo.MemoService/MoveMemo"
+	MemoService_DeleteNotebook_FullMethodName     = "/memo.MemoService/DeleteNotebook"
+	MemoService_EditSession_FullMethodName        = "/memo.MemoService/EditSession"
+	MemoService_StreamReminders_FullMethodName    = "/memo.MemoService/StreamReminders"
+	MemoService_SnoozeReminder_FullMethodName     = "/memo.MemoService/SnoozeReminder"
+	MemoService_DismissReminder_FullMethodName    = "/memo.MemoService/DismissReminder"
+	MemoService_ListMemoTemplates_FullMethodName  = "/memo.MemoService/ListMemoTemplates"
+	MemoService_CreateMemoTemplate_FullMethodName = "/memo.MemoService/CreateMemoTemplate"
+	MemoService_DeleteMemoTemplate_FullMethodName = "/memo.MemoService/DeleteMemoTemplate"
+	MemoService_GetUsage_FullMethodName           = "/memo.MemoService/GetUsage"
 )
 
 // MemoServiceClient is the client API for MemoService service.
@@ -37,6 +56,34 @@ type MemoServiceClient interface {
 	GetMultiMemos(ctx context.Context, in *GetMultiMemoRequest, opts ...grpc.CallOption) (*GetMultiMemoResponse, error)
 	ListMemos(ctx context.Context, in *ListMemosRequest, opts ...grpc.CallOption) (*ListMemosResponse, error)
 	UpdateMemo(ctx context.Context, in *UpdateMemoRequest, opts ...grpc.CallOption) (*UpdateMemoResponse, error)
+	ExportMemos(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArchiveChunk], error)
+	// ImportMemos accepts archives of up to 64 MiB and fails with
+	// RESOURCE_EXHAUSTED for larger ones.
+	ImportMemos(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ArchiveChunk, ImportMemosResponse], error)
+	ListMemoTags(ctx context.Context, in *ListMemoTagsRequest, opts ...grpc.CallOption) (*ListMemoTagsResponse, error)
+	GetMemoLinks(ctx context.Context, in *GetMemoLinksRequest, opts ...grpc.CallOption) (*GetMemoLinksResponse, error)
+	GetMemoGraph(ctx context.Context, in *GetMemoGraphRequest, opts ...grpc.CallOption) (*GetMemoGraphResponse, error)
+	GetMemoHistory(ctx context.Context, in *GetMemoHistoryRequest, opts ...grpc.CallOption) (*GetMemoHistoryResponse, error)
+	RevertMemo(ctx context.Context, in *RevertMemoRequest, opts ...grpc.CallOption) (*RevertMemoResponse, error)
+	CreateNotebook(ctx context.Context, in *CreateNotebookRequest, opts ...grpc.CallOption) (*CreateNotebookResponse, error)
+	ListNotebooks(ctx context.Context, in *ListNotebooksRequest, opts ...grpc.CallOption) (*ListNotebooksResponse, error)
+	MoveMemo(ctx context.Context, in *MoveMemoRequest, opts ...grpc.CallOption) (*MoveMemoResponse, error)
+	DeleteNotebook(ctx context.Context, in *DeleteNotebookRequest, opts ...grpc.CallOption) (*DeleteNotebookResponse, error)
+	// EditSession edits a memo together with everyone else editing it.
+	// The first message must carry memo_id and join.
+	EditSession(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[EditOp, EditOp], error)
+	// StreamReminders sends the caller's reminders as they fall due, starting
+	// with those that are already due and not yet dismissed.
+	StreamReminders(ctx context.Context, in *StreamRemindersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Reminder], error)
+	SnoozeReminder(ctx context.Context, in *SnoozeReminderRequest, opts ...grpc.CallOption) (*SnoozeReminderResponse, error)
+	DismissReminder(ctx context.Context, in *DismissReminderRequest, opts ...grpc.CallOption) (*DismissReminderResponse, error)
+	// Templates belong to the caller: authenticated users each have their own.
+	ListMemoTemplates(ctx context.Context, in *ListMemoTemplatesRequest, opts ...grpc.CallOption) (*ListMemoTemplatesResponse, error)
+	CreateMemoTemplate(ctx context.Context, in *CreateMemoTemplateRequest, opts ...grpc.CallOption) (*CreateMemoTemplateResponse, error)
+	DeleteMemoTemplate(ctx context.Context, in *DeleteMemoTemplateRequest, opts ...grpc.CallOption) (*DeleteMemoTemplateResponse, error)
+	// GetUsage reports the storage counted against the caller's quota.
+	// Writes over a limit fail with RESOURCE_EXHAUSTED and a google.rpc.QuotaFailure.
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error)
 }
 
 type memoServiceClient struct {
@@ -107,6 +154,220 @@ func (c *memoServiceClient) UpdateMemo(ctx context.Context, in *UpdateMemoReques
 	return out, nil
 }
 
+func (c *memoServiceClient) ExportMemos(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArchiveChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MemoService_ServiceDesc.Streams[0], MemoService_ExportMemos_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportRequest, ArchiveChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MemoService_ExportMemosClient = grpc.ServerStreamingClient[ArchiveChunk]
+
+func (c *memoServiceClient) ImportMemos(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ArchiveChunk, ImportMemosResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MemoService_ServiceDesc.Streams[1], MemoService_ImportMemos_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ArchiveChunk, ImportMemosResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MemoService_ImportMemosClient = grpc.ClientStreamingClient[ArchiveChunk, ImportMemosResponse]
+
+func (c *memoServiceClient) ListMemoTags(ctx context.Context, in *ListMemoTagsRequest, opts ...grpc.CallOption) (*ListMemoTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoTagsResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetMemoLinks(ctx context.Context, in *GetMemoLinksRequest, opts ...grpc.CallOption) (*GetMemoLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMemoLinksResponse)
+	err := c.cc.Invoke(ctx, MemoService_GetMemoLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetMemoGraph(ctx context.Context, in *GetMemoGraphRequest, opts ...grpc.CallOption) (*GetMemoGraphResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMemoGraphResponse)
+	err := c.cc.Invoke(ctx, MemoService_GetMemoGraph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetMemoHistory(ctx context.Context, in *GetMemoHistoryRequest, opts ...grpc.CallOption) (*GetMemoHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMemoHistoryResponse)
+	err := c.cc.Invoke(ctx, MemoService_GetMemoHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) RevertMemo(ctx context.Context, in *RevertMemoRequest, opts ...grpc.CallOption) (*RevertMemoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertMemoResponse)
+	err := c.cc.Invoke(ctx, MemoService_RevertMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) CreateNotebook(ctx context.Context, in *CreateNotebookRequest, opts ...grpc.CallOption) (*CreateNotebookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateNotebookResponse)
+	err := c.cc.Invoke(ctx, MemoService_CreateNotebook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListNotebooks(ctx context.Context, in *ListNotebooksRequest, opts ...grpc.CallOption) (*ListNotebooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotebooksResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListNotebooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) MoveMemo(ctx context.Context, in *MoveMemoRequest, opts ...grpc.CallOption) (*MoveMemoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveMemoResponse)
+	err := c.cc.Invoke(ctx, MemoService_MoveMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) DeleteNotebook(ctx context.Context, in *DeleteNotebookRequest, opts ...grpc.CallOption) (*DeleteNotebookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteNotebookResponse)
+	err := c.cc.Invoke(ctx, MemoService_DeleteNotebook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) EditSession(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[EditOp, EditOp], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MemoService_ServiceDesc.Streams[2], MemoService_EditSession_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[EditOp, EditOp]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MemoService_EditSessionClient = grpc.BidiStreamingClient[EditOp, EditOp]
+
+func (c *memoServiceClient) StreamReminders(ctx context.Context, in *StreamRemindersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Reminder], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MemoService_ServiceDesc.Streams[3], MemoService_StreamReminders_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamRemindersRequest, Reminder]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MemoService_StreamRemindersClient = grpc.ServerStreamingClient[Reminder]
+
+func (c *memoServiceClient) SnoozeReminder(ctx context.Context, in *SnoozeReminderRequest, opts ...grpc.CallOption) (*SnoozeReminderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SnoozeReminderResponse)
+	err := c.cc.Invoke(ctx, MemoService_SnoozeReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) DismissReminder(ctx context.Context, in *DismissReminderRequest, opts ...grpc.CallOption) (*DismissReminderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DismissReminderResponse)
+	err := c.cc.Invoke(ctx, MemoService_DismissReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListMemoTemplates(ctx context.Context, in *ListMemoTemplatesRequest, opts ...grpc.CallOption) (*ListMemoTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoTemplatesResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) CreateMemoTemplate(ctx context.Context, in *CreateMemoTemplateRequest, opts ...grpc.CallOption) (*CreateMemoTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateMemoTemplateResponse)
+	err := c.cc.Invoke(ctx, MemoService_CreateMemoTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) DeleteMemoTemplate(ctx context.Context, in *DeleteMemoTemplateRequest, opts ...grpc.CallOption) (*DeleteMemoTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMemoTemplateResponse)
+	err := c.cc.Invoke(ctx, MemoService_DeleteMemoTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageResponse)
+	err := c.cc.Invoke(ctx, MemoService_GetUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MemoServiceServer is the server API for MemoService service.
 // All implementations must embed UnimplementedMemoServiceServer
 // for forward compatibility.
@@ -117,6 +378,34 @@ type MemoServiceServer interface {
 	GetMultiMemos(context.Context, *GetMultiMemoRequest) (*GetMultiMemoResponse, error)
 	ListMemos(context.Context, *ListMemosRequest) (*ListMemosResponse, error)
 	UpdateMemo(context.Context, *UpdateMemoRequest) (*UpdateMemoResponse, error)
+	ExportMemos(*ExportRequest, grpc.ServerStreamingServer[ArchiveChunk]) error
+	// ImportMemos accepts archives of up to 64 MiB and fails with
+	// RESOURCE_EXHAUSTED for larger ones.
+	ImportMemos(grpc.ClientStreamingServer[ArchiveChunk, ImportMemosResponse]) error
+	ListMemoTags(context.Context, *ListMemoTagsRequest) (*ListMemoTagsResponse, error)
+	GetMemoLinks(context.Context, *GetMemoLinksRequest) (*GetMemoLinksResponse, error)
+	GetMemoGraph(context.Context, *GetMemoGraphRequest) (*GetMemoGraphResponse, error)
+	GetMemoHistory(context.Context, *GetMemoHistoryRequest) (*GetMemoHistoryResponse, error)
+	RevertMemo(context.Context, *RevertMemoRequest) (*RevertMemoResponse, error)
+	CreateNotebook(context.Context, *CreateNotebookRequest) (*CreateNotebookResponse, error)
+	ListNotebooks(context.Context, *ListNotebooksRequest) (*ListNotebooksResponse, error)
+	MoveMemo(context.Context, *MoveMemoRequest) (*MoveMemoResponse, error)
+	DeleteNotebook(context.Context, *DeleteNotebookRequest) (*DeleteNotebookResponse, error)
+	// EditSession edits a memo together with everyone else editing it.
+	// The first message must carry memo_id and join.
+	EditSession(grpc.BidiStreamingServer[EditOp, EditOp]) error
+	// StreamReminders sends the caller's reminders as they fall due, starting
+	// with those that are already due and not yet dismissed.
+	StreamReminders(*StreamRemindersRequest, grpc.ServerStreamingServer[Reminder]) error
+	SnoozeReminder(context.Context, *SnoozeReminderRequest) (*SnoozeReminderResponse, error)
+	DismissReminder(context.Context, *DismissReminderRequest) (*DismissReminderResponse, error)
+	// Templates belong to the caller: authenticated users each have their own.
+	ListMemoTemplates(context.Context, *ListMemoTemplatesRequest) (*ListMemoTemplatesResponse, error)
+	CreateMemoTemplate(context.Context, *CreateMemoTemplateRequest) (*CreateMemoTemplateResponse, error)
+	DeleteMemoTemplate(context.Context, *DeleteMemoTemplateRequest) (*DeleteMemoTemplateResponse, error)
+	// GetUsage reports the storage counted against the caller's quota.
+	// Writes over a limit fail with RESOURCE_EXHAUSTED and a google.rpc.QuotaFailure.
+	GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error)
 	mustEmbedUnimplementedMemoServiceServer()
 }
 
@@ -145,6 +434,63 @@ func (UnimplementedMemoServiceServer) ListMemos(context.Context, *ListMemosReque
 func (UnimplementedMemoServiceServer) UpdateMemo(context.Context, *UpdateMemoRequest) (*UpdateMemoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMemo not implemented")
 }
+func (UnimplementedMemoServiceServer) ExportMemos(*ExportRequest, grpc.ServerStreamingServer[ArchiveChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportMemos not implemented")
+}
+func (UnimplementedMemoServiceServer) ImportMemos(grpc.ClientStreamingServer[ArchiveChunk, ImportMemosResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportMemos not implemented")
+}
+func (UnimplementedMemoServiceServer) ListMemoTags(context.Context, *ListMemoTagsRequest) (*ListMemoTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoTags not implemented")
+}
+func (UnimplementedMemoServiceServer) GetMemoLinks(context.Context, *GetMemoLinksRequest) (*GetMemoLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemoLinks not implemented")
+}
+func (UnimplementedMemoServiceServer) GetMemoGraph(context.Context, *GetMemoGraphRequest) (*GetMemoGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemoGraph not implemented")
+}
+func (UnimplementedMemoServiceServer) GetMemoHistory(context.Context, *GetMemoHistoryRequest) (*GetMemoHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemoHistory not implemented")
+}
+func (UnimplementedMemoServiceServer) RevertMemo(context.Context, *RevertMemoRequest) (*RevertMemoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertMemo not implemented")
+}
+func (UnimplementedMemoServiceServer) CreateNotebook(context.Context, *CreateNotebookRequest) (*CreateNotebookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNotebook not implemented")
+}
+func (UnimplementedMemoServiceServer) ListNotebooks(context.Context, *ListNotebooksRequest) (*ListNotebooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotebooks not implemented")
+}
+func (UnimplementedMemoServiceServer) MoveMemo(context.Context, *MoveMemoRequest) (*MoveMemoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveMemo not implemented")
+}
+func (UnimplementedMemoServiceServer) DeleteNotebook(context.Context, *DeleteNotebookRequest) (*DeleteNotebookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNotebook not implemented")
+}
+func (UnimplementedMemoServiceServer) EditSession(grpc.BidiStreamingServer[EditOp, EditOp]) error {
+	return status.Errorf(codes.Unimplemented, "method EditSession not implemented")
+}
+func (UnimplementedMemoServiceServer) StreamReminders(*StreamRemindersRequest, grpc.ServerStreamingServer[Reminder]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamReminders not implemented")
+}
+func (UnimplementedMemoServiceServer) SnoozeReminder(context.Context, *SnoozeReminderRequest) (*SnoozeReminderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnoozeReminder not implemented")
+}
+func (UnimplementedMemoServiceServer) DismissReminder(context.Context, *DismissReminderRequest) (*DismissReminderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DismissReminder not implemented")
+}
+func (UnimplementedMemoServiceServer) ListMemoTemplates(context.Context, *ListMemoTemplatesRequest) (*ListMemoTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoTemplates not implemented")
+}
+func (UnimplementedMemoServiceServer) CreateMemoTemplate(context.Context, *CreateMemoTemplateRequest) (*CreateMemoTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMemoTemplate not implemented")
+}
+func (UnimplementedMemoServiceServer) DeleteMemoTemplate(context.Context, *DeleteMemoTemplateRequest) (*DeleteMemoTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMemoTemplate not implemented")
+}
+func (UnimplementedMemoServiceServer) GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
 func (UnimplementedMemoServiceServer) mustEmbedUnimplementedMemoServiceServer() {}
 func (UnimplementedMemoServiceServer) testEmbeddedByValue()                     {}
 
@@ -274,36 +620,646 @@ func _MemoService_UpdateMemo_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
-// MemoService_ServiceDesc is the grpc.ServiceDesc for MemoService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var MemoService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "memo.MemoService",
-	HandlerType: (*MemoServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateMemo",
-			Handler:    _MemoService_CreateMemo_Handler,
-		},
-		{
-			MethodName: "CreateMemoByJson",
-			Handler:    _MemoService_CreateMemoByJson_Handler,
-		},
-		{
-			MethodName: "GetMemo",
-			Handler:    _MemoService_GetMemo_Handler,
-		},
-		{
-			MethodName: "GetMultiMemos",
-			Handler:    _MemoService_GetMultiMemos_Handler,
-		},
-		{
-			MethodName: "ListMemos",
-			Handler:    _MemoService_ListMemos_Handler,
-		},
-		{
-			MethodName: "UpdateMemo",
-			Handler:    _MemoService_UpdateMemo_Handler,
+func _MemoService_ExportMemos_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MemoServiceServer).ExportMemos(m, &grpc.GenericServerStream[ExportRequest, ArchiveChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MemoService_ExportMemosServer = grpc.ServerStreamingServer[ArchiveChunk]
+
+func _MemoService_ImportMemos_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MemoServiceServer).ImportMemos(&grpc.GenericServerStream[ArchiveChunk, ImportMemosResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MemoService_ImportMemosServer = grpc.ClientStreamingServer[ArchiveChunk, ImportMemosResponse]
+
+func _MemoService_ListMemoTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoTags(ctx, req.(*ListMemoTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetMemoLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetMemoLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetMemoLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetMemoLinks(ctx, req.(*GetMemoLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetMemoGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetMemoGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetMemoGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetMemoGraph(ctx, req.(*GetMemoGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetMemoHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetMemoHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetMemoHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetMemoHistory(ctx, req.(*GetMemoHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_RevertMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).RevertMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_RevertMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).RevertMemo(ctx, req.(*RevertMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_CreateNotebook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNotebookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).CreateNotebook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_CreateNotebook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).CreateNotebook(ctx, req.(*CreateNotebookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListNotebooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotebooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListNotebooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListNotebooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListNotebooks(ctx, req.(*ListNotebooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_MoveMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).MoveMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_MoveMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).MoveMemo(ctx, req.(*MoveMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_DeleteNotebook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNotebookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).DeleteNotebook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_DeleteNotebook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).DeleteNotebook(ctx, req.(*DeleteNotebookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_EditSession_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MemoServiceServer).EditSession(&grpc.GenericServerStream[EditOp, EditOp]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MemoService_EditSessionServer = grpc.BidiStreamingServer[EditOp, EditOp]
+
+func _MemoService_StreamReminders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRemindersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MemoServiceServer).StreamReminders(m, &grpc.GenericServerStream[StreamRemindersRequest, Reminder]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MemoService_StreamRemindersServer = grpc.ServerStreamingServer[Reminder]
+
+func _MemoService_SnoozeReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnoozeReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).SnoozeReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_SnoozeReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).SnoozeReminder(ctx, req.(*SnoozeReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_DismissReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DismissReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).DismissReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_DismissReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).DismissReminder(ctx, req.(*DismissReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListMemoTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoTemplates(ctx, req.(*ListMemoTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_CreateMemoTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMemoTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).CreateMemoTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_CreateMemoTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).CreateMemoTemplate(ctx, req.(*CreateMemoTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_DeleteMemoTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMemoTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).DeleteMemoTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_DeleteMemoTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).DeleteMemoTemplate(ctx, req.(*DeleteMemoTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetUsage(ctx, req.(*GetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MemoService_ServiceDesc is the grpc.ServiceDesc for MemoService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MemoService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "memo.MemoService",
+	HandlerType: (*MemoServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateMemo",
+			Handler:    _MemoService_CreateMemo_Handler,
+		},
+		{
+			MethodName: "CreateMemoByJson",
+			Handler:    _MemoService_CreateMemoByJson_Handler,
+		},
+		{
+			MethodName: "GetMemo",
+			Handler:    _MemoService_GetMemo_Handler,
+		},
+		{
+			MethodName: "GetMultiMemos",
+			Handler:    _MemoService_GetMultiMemos_Handler,
+		},
+		{
+			MethodName: "ListMemos",
+			Handler:    _MemoService_ListMemos_Handler,
+		},
+		{
+			MethodName: "UpdateMemo",
+			Handler:    _MemoService_UpdateMemo_Handler,
+		},
+		{
+			MethodName: "ListMemoTags",
+			Handler:    _MemoService_ListMemoTags_Handler,
+		},
+		{
+			MethodName: "GetMemoLinks",
+			Handler:    _MemoService_GetMemoLinks_Handler,
+		},
+		{
+			MethodName: "GetMemoGraph",
+			Handler:    _MemoService_GetMemoGraph_Handler,
+		},
+		{
+			MethodName: "GetMemoHistory",
+			Handler:    _MemoService_GetMemoHistory_Handler,
+		},
+		{
+			MethodName: "RevertMemo",
+			Handler:    _MemoService_RevertMemo_Handler,
+		},
+		{
+			MethodName: "CreateNotebook",
+			Handler:    _MemoService_CreateNotebook_Handler,
+		},
+		{
+			MethodName: "ListNotebooks",
+			Handler:    _MemoService_ListNotebooks_Handler,
+		},
+		{
+			MethodName: "MoveMemo",
+			Handler:    _MemoService_MoveMemo_Handler,
+		},
+		{
+			MethodName: "DeleteNotebook",
+			Handler:    _MemoService_DeleteNotebook_Handler,
+		},
+		{
+			MethodName: "SnoozeReminder",
+			Handler:    _MemoService_SnoozeReminder_Handler,
+		},
+		{
+			MethodName: "DismissReminder",
+			Handler:    _MemoService_DismissReminder_Handler,
+		},
+		{
+			MethodName: "ListMemoTemplates",
+			Handler:    _MemoService_ListMemoTemplates_Handler,
+		},
+		{
+			MethodName: "CreateMemoTemplate",
+			Handler:    _MemoService_CreateMemoTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteMemoTemplate",
+			Handler:    _MemoService_DeleteMemoTemplate_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _MemoService_GetUsage_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportMemos",
+			Handler:       _MemoService_ExportMemos_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportMemos",
+			Handler:       _MemoService_ImportMemos_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "EditSession",
+			Handler:       _MemoService_EditSession_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StreamReminders",
+			Handler:       _MemoService_StreamReminders_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/api/memo.proto",
+}
+
+const (
+	MemoAdminService_CheckIntegrity_FullMethodName  = "/memo.MemoAdminService/CheckIntegrity"
+	MemoAdminService_CreateSnapshot_FullMethodName  = "/memo.MemoAdminService/CreateSnapshot"
+	MemoAdminService_ListSnapshots_FullMethodName   = "/memo.MemoAdminService/ListSnapshots"
+	MemoAdminService_RestoreSnapshot_FullMethodName = "/memo.MemoAdminService/RestoreSnapshot"
+)
+
+// MemoAdminServiceClient is the client API for MemoAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// MemoAdminService operates on the whole memo folder, across every user.
+type MemoAdminServiceClient interface {
+	CheckIntegrity(ctx context.Context, in *CheckIntegrityRequest, opts ...grpc.CallOption) (*CheckIntegrityResponse, error)
+	CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error)
+	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	// Memo writes wait until the restore has finished.
+	RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error)
+}
+
+type memoAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMemoAdminServiceClient(cc grpc.ClientConnInterface) MemoAdminServiceClient {
+	return &memoAdminServiceClient{cc}
+}
+
+func (c *memoAdminServiceClient) CheckIntegrity(ctx context.Context, in *CheckIntegrityRequest, opts ...grpc.CallOption) (*CheckIntegrityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckIntegrityResponse)
+	err := c.cc.Invoke(ctx, MemoAdminService_CheckIntegrity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoAdminServiceClient) CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSnapshotResponse)
+	err := c.cc.Invoke(ctx, MemoAdminService_CreateSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoAdminServiceClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, MemoAdminService_ListSnapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoAdminServiceClient) RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreSnapshotResponse)
+	err := c.cc.Invoke(ctx, MemoAdminService_RestoreSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MemoAdminServiceServer is the server API for MemoAdminService service.
+// All implementations must embed UnimplementedMemoAdminServiceServer
+// for forward compatibility.
+//
+// MemoAdminService operates on the whole memo folder, across every user.
+type MemoAdminServiceServer interface {
+	CheckIntegrity(context.Context, *CheckIntegrityRequest) (*CheckIntegrityResponse, error)
+	CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error)
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error)
+	// Memo writes wait until the restore has finished.
+	RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error)
+	mustEmbedUnimplementedMemoAdminServiceServer()
+}
+
+// UnimplementedMemoAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMemoAdminServiceServer struct{}
+
+func (UnimplementedMemoAdminServiceServer) CheckIntegrity(context.Context, *CheckIntegrityRequest) (*CheckIntegrityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIntegrity not implemented")
+}
+func (UnimplementedMemoAdminServiceServer) CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshot not implemented")
+}
+func (UnimplementedMemoAdminServiceServer) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (UnimplementedMemoAdminServiceServer) RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSnapshot not implemented")
+}
+func (UnimplementedMemoAdminServiceServer) mustEmbedUnimplementedMemoAdminServiceServer() {}
+func (UnimplementedMemoAdminServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeMemoAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MemoAdminServiceServer will
+// result in compilation errors.
+type UnsafeMemoAdminServiceServer interface {
+	mustEmbedUnimplementedMemoAdminServiceServer()
+}
+
+func RegisterMemoAdminServiceServer(s grpc.ServiceRegistrar, srv MemoAdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMemoAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MemoAdminService_ServiceDesc, srv)
+}
+
+func _MemoAdminService_CheckIntegrity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckIntegrityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoAdminServiceServer).CheckIntegrity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoAdminService_CheckIntegrity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoAdminServiceServer).CheckIntegrity(ctx, req.(*CheckIntegrityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoAdminService_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoAdminServiceServer).CreateSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoAdminService_CreateSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoAdminServiceServer).CreateSnapshot(ctx, req.(*CreateSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoAdminService_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoAdminServiceServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoAdminService_ListSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoAdminServiceServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoAdminService_RestoreSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoAdminServiceServer).RestoreSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoAdminService_RestoreSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoAdminServiceServer).RestoreSnapshot(ctx, req.(*RestoreSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MemoAdminService_ServiceDesc is the grpc.ServiceDesc for MemoAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MemoAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "memo.MemoAdminService",
+	HandlerType: (*MemoAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CheckIntegrity",
+			Handler:    _MemoAdminService_CheckIntegrity_Handler,
+		},
+		{
+			MethodName: "CreateSnapshot",
+			Handler:    _MemoAdminService_CreateSnapshot_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _MemoAdminService_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "RestoreSnapshot",
+			Handler:    _MemoAdminService_RestoreSnapshot_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
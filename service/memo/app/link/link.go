@@ -0,0 +1,227 @@
+package link
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// linkPattern matches [[Target]], [[Target|alias]] and [[Target#heading]].
+var linkPattern = regexp.MustCompile(`\[\[([^\[\]|#]+)((?:#[^\[\]|]*)?(?:\|[^\[\]]*)?)\]\]`)
+
+// Extract returns the distinct link targets in content, in order of appearance.
+func Extract(content string) []string {
+	seen := make(map[string]bool)
+	var targets []string
+	for _, match := range linkPattern.FindAllStringSubmatch(content, -1) {
+		target := strings.TrimSpace(match[1])
+		key := strings.ToLower(target)
+		if target == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		targets = append(targets, target)
+	}
+	return targets
+}
+
+// RewriteTarget replaces links to oldTarget with links to newTarget,
+// keeping any heading or alias suffix.
+func RewriteTarget(content, oldTarget, newTarget string) string {
+	return linkPattern.ReplaceAllStringFunc(content, func(match string) string {
+		parts := linkPattern.FindStringSubmatch(match)
+		if !strings.EqualFold(strings.TrimSpace(parts[1]), oldTarget) {
+			return match
+		}
+		return "[[" + newTarget + parts[2] + "]]"
+	})
+}
+
+// Edge is a resolved link from one memo to another.
+type Edge struct {
+	SourceID string
+	TargetID string
+}
+
+type node struct {
+	title   string
+	targets []string
+}
+
+// Graph keeps the links between memos. Targets are resolved when queried, so a
+// link becomes resolved as soon as a memo with a matching ID or title exists.
+type Graph struct {
+	mu    sync.RWMutex
+	nodes map[string]*node
+}
+
+// NewGraph creates an empty link graph.
+func NewGraph() *Graph {
+	return &Graph{nodes: make(map[string]*node)}
+}
+
+// Update records the title and outgoing links of a memo.
+func (g *Graph) Update(id, title, content string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.nodes[id] = &node{title: title, targets: Extract(content)}
+}
+
+// Remove drops a memo from the graph.
+func (g *Graph) Remove(id string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	delete(g.nodes, id)
+}
+
+// Title returns the title of a memo in the graph.
+func (g *Graph) Title(id string) (string, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	n, ok := g.nodes[id]
+	if !ok {
+		return "", false
+	}
+	return n.title, true
+}
+
+// IDs returns the IDs of every memo in the graph, sorted.
+func (g *Graph) IDs() []string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	ids := make([]string, 0, len(g.nodes))
+	for id := range g.nodes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Links returns the resolved outgoing links, the backlinks and the unresolved
+// targets of a memo.
+func (g *Graph) Links(id string) (outgoing, backlinks, unresolved []string) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	titles := g.titleIndex()
+
+	if n, ok := g.nodes[id]; ok {
+		for _, target := range n.targets {
+			if targetID, ok := g.resolve(titles, target); ok {
+				outgoing = append(outgoing, targetID)
+			} else {
+				unresolved = append(unresolved, target)
+			}
+		}
+	}
+
+	for sourceID, n := range g.nodes {
+		if sourceID == id {
+			continue
+		}
+		for _, target := range n.targets {
+			if targetID, ok := g.resolve(titles, target); ok && targetID == id {
+				backlinks = append(backlinks, sourceID)
+				break
+			}
+		}
+	}
+	sort.Strings(backlinks)
+
+	return outgoing, backlinks, unresolved
+}
+
+// Edges returns every resolved link in the graph.
+func (g *Graph) Edges() []Edge {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	titles := g.titleIndex()
+
+	var edges []Edge
+	for sourceID, n := range g.nodes {
+		for _, target := range n.targets {
+			if targetID, ok := g.resolve(titles, target); ok {
+				edges = append(edges, Edge{SourceID: sourceID, TargetID: targetID})
+			}
+		}
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].SourceID != edges[j].SourceID {
+			return edges[i].SourceID < edges[j].SourceID
+		}
+		return edges[i].TargetID < edges[j].TargetID
+	})
+	return edges
+}
+
+// titleIndex maps lower-cased titles to memo IDs. When titles collide the
+// smallest ID wins so that resolution is stable.
+func (g *Graph) titleIndex() map[string]string {
+	titles := make(map[string]string, len(g.nodes))
+	for id, n := range g.nodes {
+		key := strings.ToLower(n.title)
+		if existing, ok := titles[key]; !ok || id < existing {
+			titles[key] = id
+		}
+	}
+	return titles
+}
+
+// resolve finds the memo a target refers to, by ID first and then by title.
+func (g *Graph) resolve(titles map[string]string, target string) (string, bool) {
+	if _, ok := g.nodes[target]; ok {
+		return target, true
+	}
+	id, ok := titles[strings.ToLower(target)]
+	return id, ok
+}
+
+// Index holds one Graph per memo namespace.
+type Index struct {
+	mu     sync.Mutex
+	graphs map[string]*Graph
+}
+
+// NewIndex creates an empty Index.
+func NewIndex() *Index {
+	return &Index{graphs: make(map[string]*Graph)}
+}
+
+// Graph returns the graph of a namespace, building it with load on first use.
+func (i *Index) Graph(namespace string, load func(g *Graph) error) (*Graph, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if g, ok := i.graphs[namespace]; ok {
+		return g, nil
+	}
+
+	g := NewGraph()
+	if err := load(g); err != nil {
+		return nil, err
+	}
+	i.graphs[namespace] = g
+	return g, nil
+}
+
+// Invalidate drops the graph of a namespace so that it is rebuilt on next use.
+func (i *Index) Invalidate(namespace string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	delete(i.graphs, namespace)
+}
+
+// Reset drops the graphs of all namespaces.
+func (i *Index) Reset() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.graphs = make(map[string]*Graph)
+}
@@ -0,0 +1,54 @@
+package link
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtract(t *testing.T) {
+	content := "See [[Meeting Notes]], [[meeting notes|again]], [[abc-123#todo]] and [[ ]]."
+
+	got := Extract(content)
+	want := []string{"Meeting Notes", "abc-123"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestRewriteTarget(t *testing.T) {
+	content := "[[Old]] [[old|alias]] [[Old#heading]] [[Other]]"
+
+	got := RewriteTarget(content, "Old", "New")
+	want := "[[New]] [[New|alias]] [[New#heading]] [[Other]]"
+	if got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestGraphLinks(t *testing.T) {
+	g := NewGraph()
+	g.Update("a", "Alpha", "links to [[Beta]] and [[c]] and [[Missing]]")
+	g.Update("b", "Beta", "back to [[alpha]]")
+	g.Update("c", "Gamma", "no links")
+
+	outgoing, backlinks, unresolved := g.Links("a")
+	if !reflect.DeepEqual(outgoing, []string{"b", "c"}) {
+		t.Errorf("Unexpected outgoing links: %v", outgoing)
+	}
+	if !reflect.DeepEqual(backlinks, []string{"b"}) {
+		t.Errorf("Unexpected backlinks: %v", backlinks)
+	}
+	if !reflect.DeepEqual(unresolved, []string{"Missing"}) {
+		t.Errorf("Unexpected unresolved links: %v", unresolved)
+	}
+
+	// Creating the missing memo resolves the link.
+	g.Update("d", "Missing", "")
+	if _, _, unresolved := g.Links("a"); len(unresolved) != 0 {
+		t.Errorf("Expected no unresolved links, got %v", unresolved)
+	}
+
+	if edges := g.Edges(); len(edges) != 4 {
+		t.Errorf("Expected 4 edges, got %v", edges)
+	}
+}
@@ -0,0 +1,87 @@
+// Package quota checks writes against limits on memo size, memo count and
+// total storage.
+package quota
+
+import "fmt"
+
+// Limits bounds the memos of a user or of the whole memo folder.
+// A zero limit is unlimited.
+type Limits struct {
+	MaxContentBytes int64
+	MaxMemos        int
+	MaxTotalBytes   int64
+}
+
+// Unlimited reports whether no limit is set.
+func (l Limits) Unlimited() bool {
+	return l.MaxContentBytes <= 0 && l.MaxMemos <= 0 && l.MaxTotalBytes <= 0
+}
+
+// Usage is the storage currently taken.
+type Usage struct {
+	Memos int
+	Bytes int64
+}
+
+// Add combines c with another write so that both are checked at once.
+// Sizes add up, and the larger content is the one checked against MaxContentBytes.
+func (c Change) Add(other Change) Change {
+	return Change{
+		ContentBytes: max(c.ContentBytes, other.ContentBytes),
+		Memos:        c.Memos + other.Memos,
+		Bytes:        c.Bytes + other.Bytes,
+	}
+}
+
+// Change describes a write.
+type Change struct {
+	// ContentBytes is the size of the content written, or 0 when the write
+	// does not touch content.
+	ContentBytes int64
+	// Memos is the number of memos the write adds.
+	Memos int
+	// Bytes is how much the write grows the storage. It is negative when the
+	// new content is smaller than the old one.
+	Bytes int64
+}
+
+// Metric names the limit a Violation is about.
+type Metric string
+
+const (
+	MetricContentBytes Metric = "content_bytes"
+	MetricMemos        Metric = "memos"
+	MetricTotalBytes   Metric = "total_bytes"
+)
+
+// Violation is a limit a write would exceed.
+type Violation struct {
+	Metric      Metric
+	Description string
+}
+
+// Check returns the limits that the change would exceed. Writes that do not
+// grow a value are allowed even when it is already over its limit, so users
+// can always shrink or delete memos to get back under quota.
+func (l Limits) Check(usage Usage, change Change) []Violation {
+	var violations []Violation
+	if l.MaxContentBytes > 0 && change.ContentBytes > l.MaxContentBytes {
+		violations = append(violations, Violation{
+			Metric:      MetricContentBytes,
+			Description: fmt.Sprintf("content is %d bytes, the limit is %d bytes", change.ContentBytes, l.MaxContentBytes),
+		})
+	}
+	if l.MaxMemos > 0 && change.Memos > 0 && usage.Memos+change.Memos > l.MaxMemos {
+		violations = append(violations, Violation{
+			Metric:      MetricMemos,
+			Description: fmt.Sprintf("%d memos are stored, the limit is %d memos", usage.Memos, l.MaxMemos),
+		})
+	}
+	if l.MaxTotalBytes > 0 && change.Bytes > 0 && usage.Bytes+change.Bytes > l.MaxTotalBytes {
+		violations = append(violations, Violation{
+			Metric:      MetricTotalBytes,
+			Description: fmt.Sprintf("%d bytes are stored and the write adds %d bytes, the limit is %d bytes", usage.Bytes, change.Bytes, l.MaxTotalBytes),
+		})
+	}
+	return violations
+}
@@ -0,0 +1,40 @@
+package quota
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	limits := Limits{MaxContentBytes: 100, MaxMemos: 3, MaxTotalBytes: 1000}
+
+	tests := []struct {
+		name   string
+		usage  Usage
+		change Change
+		want   []Metric
+	}{
+		{"within limits", Usage{Memos: 2, Bytes: 500}, Change{ContentBytes: 100, Memos: 1, Bytes: 100}, nil},
+		{"content too large", Usage{}, Change{ContentBytes: 101, Memos: 1, Bytes: 101}, []Metric{MetricContentBytes}},
+		{"too many memos", Usage{Memos: 3, Bytes: 300}, Change{ContentBytes: 10, Memos: 1, Bytes: 10}, []Metric{MetricMemos}},
+		{"storage full", Usage{Memos: 1, Bytes: 950}, Change{ContentBytes: 60, Bytes: 60}, []Metric{MetricTotalBytes}},
+		{"shrinking over quota", Usage{Memos: 5, Bytes: 2000}, Change{ContentBytes: 50, Bytes: -40}, nil},
+		{"everything", Usage{Memos: 3, Bytes: 1000}, Change{ContentBytes: 200, Memos: 1, Bytes: 200}, []Metric{MetricContentBytes, MetricMemos, MetricTotalBytes}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []Metric
+			for _, v := range limits.Check(tt.usage, tt.change) {
+				got = append(got, v.Metric)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	if v := (Limits{}).Check(Usage{Memos: 1 << 20, Bytes: 1 << 40}, Change{ContentBytes: 1 << 30, Memos: 1, Bytes: 1 << 30}); v != nil {
+		t.Errorf("Expected no limits to allow everything, got %v", v)
+	}
+}
@@ -0,0 +1,355 @@
+// Package reminder schedules memo reminders and delivers them to subscribers.
+//
+// Reminders are kept in a JSON file so that they survive restarts. A reminder
+// stays active from the time it is due until it is dismissed or snoozed, and
+// every new subscriber receives the active reminders of its namespace, so
+// reminders that fell due while the server was down or nobody was listening
+// are still delivered.
+package reminder
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// FileName is the name of the file, in the memo folder, that holds the reminders.
+// It is hidden so that the FileService does not treat it as a memo.
+const FileName = ".reminders.json"
+
+// subscriberBuffer is the number of reminders queued for a subscriber. Reminders
+// that do not fit are not lost: they stay active and are sent on resubscribe.
+const subscriberBuffer = 64
+
+// maxWait bounds how long the scheduler sleeps, so clock changes are noticed.
+const maxWait = time.Minute
+
+// ErrNotFound is returned when a memo has no reminder.
+var ErrNotFound = errors.New("reminder not found")
+
+// Reminder is a reminder on a memo.
+type Reminder struct {
+	Namespace string    `json:"namespace,omitempty"`
+	MemoID    string    `json:"memo_id"`
+	RemindAt  time.Time `json:"remind_at"`
+	// DeliveredAt is when the reminder was first sent to a subscriber.
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+}
+
+// Due reports whether the reminder is due at now.
+func (r Reminder) Due(now time.Time) bool {
+	return !r.RemindAt.After(now)
+}
+
+type key struct {
+	namespace string
+	memoID    string
+}
+
+// Subscription receives the reminders of a namespace as they fall due.
+type Subscription struct {
+	namespace string
+	ch        chan Reminder
+}
+
+// C returns the channel reminders are sent on.
+func (s *Subscription) C() <-chan Reminder {
+	return s.ch
+}
+
+// Scheduler keeps reminders and sends them to subscribers when they fall due.
+type Scheduler struct {
+	path string
+	now  func() time.Time
+
+	mu          sync.Mutex
+	reminders   map[key]*Reminder
+	subscribers map[*Subscription]struct{}
+	wake        chan struct{}
+}
+
+// Open loads the reminders stored in the folder.
+func Open(folder string) (*Scheduler, error) {
+	s := &Scheduler{
+		path:        filepath.Join(folder, FileName),
+		now:         time.Now,
+		reminders:   make(map[key]*Reminder),
+		subscribers: make(map[*Subscription]struct{}),
+		wake:        make(chan struct{}, 1),
+	}
+
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read reminders: %w", err)
+	}
+
+	var reminders []*Reminder
+	if err := json.Unmarshal(data, &reminders); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", s.path, err)
+	}
+	for _, r := range reminders {
+		s.reminders[key{r.Namespace, r.MemoID}] = r
+	}
+	return s, nil
+}
+
+// Get returns the reminder on a memo.
+func (s *Scheduler) Get(namespace, memoID string) (Reminder, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.reminders[key{namespace, memoID}]
+	if !ok {
+		return Reminder{}, false
+	}
+	return *r, true
+}
+
+// Set sets or replaces the reminder on a memo.
+func (s *Scheduler) Set(namespace, memoID string, remindAt time.Time) (Reminder, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r := &Reminder{Namespace: namespace, MemoID: memoID, RemindAt: remindAt.UTC()}
+	k := key{namespace, memoID}
+	previous := s.reminders[k]
+	s.reminders[k] = r
+	if err := s.save(); err != nil {
+		s.restore(k, previous)
+		return Reminder{}, err
+	}
+
+	s.notify()
+	return *r, nil
+}
+
+// Snooze moves the reminder on a memo to until, making it pending again.
+func (s *Scheduler) Snooze(namespace, memoID string, until time.Time) (Reminder, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k := key{namespace, memoID}
+	previous, ok := s.reminders[k]
+	if !ok {
+		return Reminder{}, fmt.Errorf("%w: memo %s", ErrNotFound, memoID)
+	}
+
+	r := &Reminder{Namespace: namespace, MemoID: memoID, RemindAt: until.UTC()}
+	s.reminders[k] = r
+	if err := s.save(); err != nil {
+		s.restore(k, previous)
+		return Reminder{}, err
+	}
+
+	s.notify()
+	return *r, nil
+}
+
+// Dismiss removes the reminder on a memo.
+func (s *Scheduler) Dismiss(namespace, memoID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k := key{namespace, memoID}
+	previous, ok := s.reminders[k]
+	if !ok {
+		return fmt.Errorf("%w: memo %s", ErrNotFound, memoID)
+	}
+
+	delete(s.reminders, k)
+	if err := s.save(); err != nil {
+		s.restore(k, previous)
+		return err
+	}
+	return nil
+}
+
+// Subscribe starts receiving the reminders of a namespace. The active
+// reminders, which are already due, are sent right away.
+func (s *Scheduler) Subscribe(namespace string) *Subscription {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub := &Subscription{namespace: namespace, ch: make(chan Reminder, subscriberBuffer)}
+	s.subscribers[sub] = struct{}{}
+
+	now := s.now()
+	due := make([]*Reminder, 0)
+	for _, r := range s.reminders {
+		if r.Namespace == namespace && r.Due(now) {
+			due = append(due, r)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].RemindAt.Before(due[j].RemindAt)
+	})
+
+	delivered := false
+	for _, r := range due {
+		if s.send(sub, r, now) {
+			delivered = true
+		}
+	}
+	if delivered {
+		s.saveOrLog()
+	}
+	return sub
+}
+
+// Unsubscribe stops a subscription and closes its channel.
+func (s *Scheduler) Unsubscribe(sub *Subscription) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.subscribers[sub]; ok {
+		delete(s.subscribers, sub)
+		close(sub.ch)
+	}
+}
+
+// Close ends every subscription.
+func (s *Scheduler) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for sub := range s.subscribers {
+		delete(s.subscribers, sub)
+		close(sub.ch)
+	}
+}
+
+// Run sends reminders to subscribers as they fall due until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		case <-s.wake:
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+		}
+
+		timer.Reset(s.deliverDue())
+	}
+}
+
+// deliverDue sends the reminders that fell due since they were last sent
+// and returns how long to wait for the next one.
+func (s *Scheduler) deliverDue() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	wait := maxWait
+	delivered := false
+	for _, r := range s.reminders {
+		if r.DeliveredAt != nil {
+			continue
+		}
+		if !r.Due(now) {
+			wait = min(wait, r.RemindAt.Sub(now))
+			continue
+		}
+		// Reminders nobody receives now are sent when someone subscribes.
+		for sub := range s.subscribers {
+			if sub.namespace == r.Namespace && s.send(sub, r, now) {
+				delivered = true
+			}
+		}
+	}
+	if delivered {
+		s.saveOrLog()
+	}
+	return wait
+}
+
+// send queues r for sub and records the first delivery. s.mu must be held.
+func (s *Scheduler) send(sub *Subscription, r *Reminder, now time.Time) bool {
+	sent := *r
+	if sent.DeliveredAt == nil {
+		deliveredAt := now.UTC()
+		sent.DeliveredAt = &deliveredAt
+	}
+
+	select {
+	case sub.ch <- sent:
+		r.DeliveredAt = sent.DeliveredAt
+		return true
+	default:
+		return false
+	}
+}
+
+// notify wakes Run up to reschedule. s.mu must be held.
+func (s *Scheduler) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// restore puts back the previous reminder of k after a failed save. s.mu must be held.
+func (s *Scheduler) restore(k key, previous *Reminder) {
+	if previous == nil {
+		delete(s.reminders, k)
+		return
+	}
+	s.reminders[k] = previous
+}
+
+// save writes the reminders to disk atomically. s.mu must be held.
+func (s *Scheduler) save() error {
+	reminders := make([]*Reminder, 0, len(s.reminders))
+	for _, r := range s.reminders {
+		reminders = append(reminders, r)
+	}
+	sort.Slice(reminders, func(i, j int) bool {
+		if reminders[i].Namespace != reminders[j].Namespace {
+			return reminders[i].Namespace < reminders[j].Namespace
+		}
+		return reminders[i].MemoID < reminders[j].MemoID
+	})
+
+	data, err := json.MarshalIndent(reminders, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode reminders: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return fmt.Errorf("failed to save reminders: %w", err)
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to save reminders: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("failed to save reminders: %w", err)
+	}
+	return nil
+}
+
+// saveOrLog saves after a delivery. Losing DeliveredAt only means the
+// reminder may be sent again, so failures are logged. s.mu must be held.
+func (s *Scheduler) saveOrLog() {
+	if err := s.save(); err != nil {
+		log.Printf("failed to save reminders: %v", err)
+	}
+}
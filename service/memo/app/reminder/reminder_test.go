@@ -0,0 +1,87 @@
+package reminder
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMissedRemindersSurviveRestart(t *testing.T) {
+	folder := t.TempDir()
+
+	s, err := Open(folder)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	if _, err := s.Set("alice", "missed", time.Now().Add(-time.Hour)); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+	if _, err := s.Set("alice", "later", time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+	if _, err := s.Set("bob", "other", time.Now().Add(-time.Hour)); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+
+	// A new scheduler stands in for a restarted server.
+	s, err = Open(folder)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	sub := s.Subscribe("alice")
+	defer s.Unsubscribe(sub)
+
+	select {
+	case r := <-sub.C():
+		if r.MemoID != "missed" {
+			t.Errorf("Expected the missed reminder, got %+v", r)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected the missed reminder on subscribe")
+	}
+	select {
+	case r := <-sub.C():
+		t.Errorf("Unexpected reminder %+v", r)
+	default:
+	}
+
+	if r, _ := s.Get("alice", "missed"); r.DeliveredAt == nil {
+		t.Error("Expected the reminder to be marked as delivered")
+	}
+}
+
+func TestRunDeliversSnoozedReminder(t *testing.T) {
+	s, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.Run(ctx)
+
+	sub := s.Subscribe("")
+	defer s.Unsubscribe(sub)
+
+	if _, err := s.Set("", "memo", time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+	if _, err := s.Snooze("", "memo", time.Now().Add(50*time.Millisecond)); err != nil {
+		t.Fatalf("Snooze failed: %v", err)
+	}
+
+	select {
+	case r := <-sub.C():
+		if r.MemoID != "memo" {
+			t.Errorf("Unexpected reminder %+v", r)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected the snoozed reminder to be delivered")
+	}
+
+	if err := s.Dismiss("", "memo"); err != nil {
+		t.Fatalf("Dismiss failed: %v", err)
+	}
+	if _, err := s.Snooze("", "memo", time.Now()); err == nil {
+		t.Error("Expected an error when snoozing a dismissed reminder")
+	}
+}
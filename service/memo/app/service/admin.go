@@ -0,0 +1,54 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	pb "memo/grpc"
+	"memo/snapshot"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	timePkg "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AdminService implements MemoAdminService. It works on the shared memo folder
+// directly, so every user's namespace is covered.
+type AdminService struct {
+	pb.UnimplementedMemoAdminServiceServer
+	memo *MemoService
+	// snapshots is nil unless snapshots are enabled.
+	snapshots *snapshot.Manager
+}
+
+func NewAdminService(memo *MemoService, snapshots *snapshot.Manager) *AdminService {
+	return &AdminService{
+		memo:      memo,
+		snapshots: snapshots,
+	}
+}
+
+// snapshotManager returns the snapshot manager, or an error if snapshots are disabled.
+func (s *AdminService) snapshotManager() (*snapshot.Manager, error) {
+	if s.snapshots == nil {
+		return nil, status.Error(codes.FailedPrecondition, "snapshots are not enabled")
+	}
+	return s.snapshots, nil
+}
+
+// snapshotError converts a snapshot error into a gRPC status error.
+func snapshotError(err error, msg string) error {
+	switch {
+	case errors.Is(err, snapshot.ErrNotFound), errors.Is(err, snapshot.ErrMemoNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	default:
+		return fmt.Errorf("%s: %w", msg, err)
+	}
+}
+
+func convertSnapshotToProto(snapshot *snapshot.Snapshot) *pb.Snapshot {
+	return &pb.Snapshot{
+		Id:        snapshot.ID,
+		CreatedAt: timePkg.New(snapshot.CreatedAt),
+		SizeBytes: snapshot.Size,
+	}
+}
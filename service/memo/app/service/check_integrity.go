@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"memo/db"
+	grpcPkg "memo/grpc"
+)
+
+var issueKinds = map[db.IssueKind]grpcPkg.IntegrityIssueKind{
+	db.IssueMalformedName:  grpcPkg.IntegrityIssueKind_INTEGRITY_ISSUE_KIND_MALFORMED_NAME,
+	db.IssueDuplicateID:    grpcPkg.IntegrityIssueKind_INTEGRITY_ISSUE_KIND_DUPLICATE_ID,
+	db.IssueUnreadableJSON: grpcPkg.IntegrityIssueKind_INTEGRITY_ISSUE_KIND_UNREADABLE_JSON,
+	db.IssueUnreadableFile: grpcPkg.IntegrityIssueKind_INTEGRITY_ISSUE_KIND_UNREADABLE_FILE,
+	db.IssueOrphanedFile:   grpcPkg.IntegrityIssueKind_INTEGRITY_ISSUE_KIND_ORPHANED_FILE,
+}
+
+var issueActions = map[db.IssueAction]grpcPkg.IntegrityAction{
+	db.ActionNone:        grpcPkg.IntegrityAction_INTEGRITY_ACTION_NONE,
+	db.ActionRepaired:    grpcPkg.IntegrityAction_INTEGRITY_ACTION_REPAIRED,
+	db.ActionQuarantined: grpcPkg.IntegrityAction_INTEGRITY_ACTION_QUARANTINED,
+	db.ActionFailed:      grpcPkg.IntegrityAction_INTEGRITY_ACTION_FAILED,
+}
+
+func (s *AdminService) CheckIntegrity(ctx context.Context, req *grpcPkg.CheckIntegrityRequest) (*grpcPkg.CheckIntegrityResponse, error) {
+	report, err := s.memo.FileService.CheckIntegrity(db.IntegrityOptions{
+		Repair:     req.Repair,
+		Quarantine: req.Quarantine,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check integrity: %w", err)
+	}
+
+	issues := make([]*grpcPkg.IntegrityIssue, 0, len(report.Issues))
+	for _, issue := range report.Issues {
+		issues = append(issues, &grpcPkg.IntegrityIssue{
+			Kind:   issueKinds[issue.Kind],
+			Path:   issue.Path,
+			MemoId: issue.ID,
+			Detail: issue.Detail,
+			Action: issueActions[issue.Action],
+		})
+	}
+
+	return &grpcPkg.CheckIntegrityResponse{
+		ScannedFiles: int32(report.ScannedFiles),
+		Issues:       issues,
+	}, nil
+}
@@ -7,29 +7,71 @@ import (
 	grpcPkg "memo/grpc"
 
 	"github.com/google/uuid"
-	timePkg "google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (s *MemoService) CreateMemo(ctx context.Context, req *grpcPkg.CreateMemoRequest) (*grpcPkg.CreateMemoResponse, error) {
+	fs, err := s.files(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateTitle(req.Title); err != nil {
+		return nil, err
+	}
+	if req.RemindAt != nil {
+		if err := validateRemindAt(req.RemindAt); err != nil {
+			return nil, err
+		}
+	}
+
+	content := req.Content
+	if req.TemplateId != "" {
+		if content != "" {
+			return nil, status.Error(codes.InvalidArgument, "content and template_id cannot be combined")
+		}
+		tmpl, err := fs.Templates().GetTemplate(req.TemplateId)
+		if err != nil {
+			return nil, fileServiceError(err, "failed to get template")
+		}
+		content, err = renderTemplate(ctx, tmpl, req.Title, req.Variables)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	memo := &model.Memo{
 		ID:       uuid.New().String(),
 		FileType: model.FileTypeMd,
 		Title:    req.Title,
-		Content:  req.Content,
+		Content:  content,
+		Notebook: req.Notebook,
 	}
 
-	createdMemo, err := s.FileService.CreateFile(memo)
+	var createdMemo *model.Memo
+	err = s.writeWithQuota(ctx, fs, contentChange(content, "", true), func() (err error) {
+		createdMemo, err = fs.CreateFile(memo)
+		if err != nil {
+			return fileServiceError(err, "failed to create file")
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create file: %w", err)
+		return nil, err
+	}
+	s.recordLinks(ctx, fs, createdMemo)
+	s.recordHistory(ctx, fmt.Sprintf("Create memo %s: %s", createdMemo.ID, createdMemo.Title))
+
+	if req.RemindAt != nil {
+		if _, err := s.reminders.Set(namespace(ctx), createdMemo.ID, req.RemindAt.AsTime()); err != nil {
+			return nil, fmt.Errorf("failed to set reminder: %w", err)
+		}
 	}
 
+	grpcMemo := convertMemoToProto(createdMemo)
+	s.attachReminders(ctx, grpcMemo)
 	return &grpcPkg.CreateMemoResponse{
-		Memo: &grpcPkg.Memo{
-			Id:        createdMemo.ID,
-			Title:     createdMemo.Title,
-			Content:   createdMemo.Content,
-			CreatedAt: timePkg.New(createdMemo.CreatedAt),
-			UpdatedAt: timePkg.New(createdMemo.UpdatedAt),
-		},
+		Memo: grpcMemo,
 	}, nil
 }
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"memo/db/model"
+	grpcPkg "memo/grpc"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *MemoService) CreateMemoByJson(ctx context.Context, req *grpcPkg.CreateMemoByJsonRequest) (*grpcPkg.CreateMemoByJsonResponse, error) {
+	fs, err := s.files(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateTitle(req.Title); err != nil {
+		return nil, err
+	}
+	if !json.Valid([]byte(req.Content)) {
+		return nil, status.Error(codes.InvalidArgument, "content must be valid JSON")
+	}
+
+	memo := &model.Memo{
+		ID:       uuid.New().String(),
+		FileType: model.FileTypeJson,
+		Title:    req.Title,
+		Content:  req.Content,
+	}
+
+	var createdMemo *model.Memo
+	err = s.writeWithQuota(ctx, fs, contentChange(req.Content, "", true), func() (err error) {
+		createdMemo, err = fs.CreateFile(memo)
+		if err != nil {
+			return fileServiceError(err, "failed to create file")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.recordLinks(ctx, fs, createdMemo)
+	s.recordHistory(ctx, fmt.Sprintf("Create memo %s: %s", createdMemo.ID, createdMemo.Title))
+
+	return &grpcPkg.CreateMemoByJsonResponse{
+		Memo: convertMemoToProto(createdMemo),
+	}, nil
+}
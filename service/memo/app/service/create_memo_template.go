@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"memo/db/model"
+	grpcPkg "memo/grpc"
+	"memo/quota"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *MemoService) CreateMemoTemplate(ctx context.Context, req *grpcPkg.CreateMemoTemplateRequest) (*grpcPkg.CreateMemoTemplateResponse, error) {
+	// Template names end up in file names just like memo titles.
+	if err := validateTitle(req.Name); err != nil {
+		return nil, err
+	}
+	if req.Content == "" {
+		return nil, status.Error(codes.InvalidArgument, "content is required")
+	}
+
+	fs, err := s.files(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Templates do not count towards memo usage, but the caller's content limit applies to them.
+	limits, scope, _ := s.quotaScope(ctx, fs)
+	limits = quota.Limits{MaxContentBytes: limits.MaxContentBytes}
+	if violations := limits.Check(quota.Usage{}, quota.Change{ContentBytes: int64(len(req.Content))}); len(violations) > 0 {
+		return nil, quotaError(scope, violations)
+	}
+	if _, err := parseTemplate(req.Name, req.Content); err != nil {
+		return nil, err
+	}
+	created, err := fs.Templates().CreateTemplate(&model.Memo{
+		ID:      uuid.New().String(),
+		Title:   req.Name,
+		Content: req.Content,
+	})
+	if err != nil {
+		return nil, fileServiceError(err, "failed to create template")
+	}
+	s.recordHistory(ctx, fmt.Sprintf("Create template %s: %s", created.ID, created.Title))
+
+	return &grpcPkg.CreateMemoTemplateResponse{
+		Template: convertTemplateToProto(created),
+	}, nil
+}
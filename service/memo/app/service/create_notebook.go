@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+	grpcPkg "memo/grpc"
+)
+
+func (s *MemoService) CreateNotebook(ctx context.Context, req *grpcPkg.CreateNotebookRequest) (*grpcPkg.CreateNotebookResponse, error) {
+	fs, err := s.files(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	notebook, err := fs.CreateNotebook(req.Path)
+	if err != nil {
+		return nil, fileServiceError(err, "failed to create notebook")
+	}
+
+	return &grpcPkg.CreateNotebookResponse{
+		Notebook: &grpcPkg.Notebook{Path: notebook.Path},
+	}, nil
+}
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+	grpcPkg "memo/grpc"
+	"time"
+)
+
+func (s *AdminService) CreateSnapshot(ctx context.Context, req *grpcPkg.CreateSnapshotRequest) (*grpcPkg.CreateSnapshotResponse, error) {
+	snapshots, err := s.snapshotManager()
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := snapshots.Create(time.Now())
+	if err != nil {
+		return nil, snapshotError(err, "failed to create snapshot")
+	}
+
+	return &grpcPkg.CreateSnapshotResponse{
+		Snapshot: convertSnapshotToProto(created),
+	}, nil
+}
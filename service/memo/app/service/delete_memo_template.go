@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	grpcPkg "memo/grpc"
+)
+
+func (s *MemoService) DeleteMemoTemplate(ctx context.Context, req *grpcPkg.DeleteMemoTemplateRequest) (*grpcPkg.DeleteMemoTemplateResponse, error) {
+	fs, err := s.files(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := fs.Templates().DeleteTemplate(req.Id); err != nil {
+		return nil, fileServiceError(err, "failed to delete template")
+	}
+	s.recordHistory(ctx, fmt.Sprintf("Delete template %s", req.Id))
+
+	return &grpcPkg.DeleteMemoTemplateResponse{}, nil
+}
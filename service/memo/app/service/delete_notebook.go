@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"memo/db"
+	"memo/db/model"
+	grpcPkg "memo/grpc"
+	"memo/reminder"
+)
+
+func (s *MemoService) DeleteNotebook(ctx context.Context, req *grpcPkg.DeleteNotebookRequest) (*grpcPkg.DeleteNotebookResponse, error) {
+	fs, err := s.files(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// A recursive delete removes memos, whose reminders must go with them.
+	var before []*model.Memo
+	if req.Recursive {
+		if before, err = fs.ListFiles(); err != nil {
+			return nil, fileServiceError(err, "failed to list memos")
+		}
+	}
+
+	if err := fs.DeleteNotebook(req.Path, req.Recursive); err != nil {
+		return nil, fileServiceError(err, "failed to delete notebook")
+	}
+
+	if req.Recursive {
+		// Deleted memos may have been link targets.
+		s.links.Invalidate(namespace(ctx))
+		s.dismissDeletedReminders(ctx, fs, before)
+	}
+	s.recordHistory(ctx, fmt.Sprintf("Delete notebook %q", req.Path))
+
+	return &grpcPkg.DeleteNotebookResponse{Success: true}, nil
+}
+
+// dismissDeletedReminders removes the reminders of the memos in before that no
+// longer exist. The memos are already deleted, so failures are only logged.
+func (s *MemoService) dismissDeletedReminders(ctx context.Context, fs db.FileService, before []*model.Memo) {
+	after, err := fs.ListFiles()
+	if err != nil {
+		log.Printf("failed to list memos to dismiss their reminders: %v", err)
+		return
+	}
+	remaining := make(map[string]bool, len(after))
+	for _, memo := range after {
+		remaining[memo.ID] = true
+	}
+
+	for _, memo := range before {
+		if remaining[memo.ID] {
+			continue
+		}
+		if err := s.reminders.Dismiss(namespace(ctx), memo.ID); err != nil && !errors.Is(err, reminder.ErrNotFound) {
+			log.Printf("failed to dismiss reminder of deleted memo %s: %v", memo.ID, err)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+	grpcPkg "memo/grpc"
+)
+
+func (s *MemoService) DismissReminder(ctx context.Context, req *grpcPkg.DismissReminderRequest) (*grpcPkg.DismissReminderResponse, error) {
+	if err := s.reminders.Dismiss(namespace(ctx), req.MemoId); err != nil {
+		return nil, reminderError(err, "failed to dismiss reminder")
+	}
+
+	return &grpcPkg.DismissReminderResponse{}, nil
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"memo/auth"
+	"memo/collab"
+	"memo/db"
+	"memo/db/model"
+	grpcPkg "memo/grpc"
+	"memo/quota"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *MemoService) EditSession(stream grpcPkg.MemoService_EditSessionServer) error {
+	ctx := stream.Context()
+
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to receive edit op: %w", err)
+	}
+	if first.MemoId == "" || first.GetJoin() == nil {
+		return status.Error(codes.InvalidArgument, "the first message must carry memo_id and join")
+	}
+
+	fs, err := s.files(ctx)
+	if err != nil {
+		return err
+	}
+	memo, err := fs.GetFile(first.MemoId)
+	if err != nil {
+		return fileServiceError(err, "failed to get memo")
+	}
+
+	name := first.GetJoin().Name
+	if user, ok := auth.UserFromContext(ctx); ok && name == "" {
+		name = user.Name
+	}
+	if name == "" {
+		name = "anonymous"
+	}
+
+	// The session outlives this stream, so saves must not be cancelled with it.
+	limits, scope, _ := s.quotaScope(ctx, fs)
+	store := &memoStore{
+		service:  s,
+		ctx:      context.WithoutCancel(ctx),
+		fs:       fs,
+		id:       memo.ID,
+		maxBytes: limits.MaxContentBytes,
+	}
+	session, participant, err := s.sessions.Join(namespace(ctx)+"/"+memo.ID, name, store)
+	if err != nil {
+		return fmt.Errorf("failed to join edit session: %w", err)
+	}
+	defer s.sessions.Leave(session, participant)
+
+	// A stream may be read and written concurrently by one goroutine each.
+	received := make(chan error, 1)
+	go func() {
+		received <- receiveEdits(stream, memo.ID, scope, session, participant)
+	}()
+
+	for {
+		select {
+		case event, ok := <-participant.Events():
+			if !ok {
+				return status.Error(codes.Aborted, "disconnected from the edit session, join again")
+			}
+			if err := stream.Send(convertEventToProto(memo.ID, event)); err != nil {
+				return fmt.Errorf("failed to send edit op: %w", err)
+			}
+		case err := <-received:
+			return err
+		}
+	}
+}
+
+// receiveEdits applies the messages of a participant until the client closes
+// the stream. scope is the quota scope of the participant.
+func receiveEdits(stream grpcPkg.MemoService_EditSessionServer, memoID, scope string, session *collab.Session, participant *collab.Participant) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to receive edit op: %w", err)
+		}
+		if req.MemoId != "" && req.MemoId != memoID {
+			return status.Errorf(codes.InvalidArgument, "this session edits memo %s", memoID)
+		}
+
+		switch payload := req.Payload.(type) {
+		case *grpcPkg.EditOp_Operation:
+			_, err = session.Submit(participant, int(req.Revision), convertOperationFromProto(payload.Operation))
+		case *grpcPkg.EditOp_Cursor:
+			err = session.MoveCursor(participant, int(req.Revision), convertCursorFromProto(payload.Cursor))
+		default:
+			return status.Error(codes.InvalidArgument, "only operation and cursor messages can follow join")
+		}
+		if err != nil {
+			return editSessionError(err, scope)
+		}
+	}
+}
+
+// editSessionError converts a collab error into a gRPC status error.
+func editSessionError(err error, scope string) error {
+	switch {
+	case errors.Is(err, collab.ErrTooLarge):
+		return quotaError(scope, []quota.Violation{{Metric: quota.MetricContentBytes, Description: err.Error()}})
+	case errors.Is(err, collab.ErrInvalidOperation):
+		return status.Errorf(codes.InvalidArgument, "invalid edit op: %v", err)
+	case errors.Is(err, collab.ErrStaleRevision), errors.Is(err, collab.ErrDisconnected):
+		return status.Errorf(codes.Aborted, "edit op rejected, join again: %v", err)
+	default:
+		return fmt.Errorf("failed to apply edit op: %w", err)
+	}
+}
+
+// memoStore loads and saves the document of an edit session through the FileService.
+type memoStore struct {
+	service *MemoService
+	ctx     context.Context
+	fs      db.FileService
+	id      string
+	// maxBytes is the content size limit of the participant that started the session.
+	maxBytes int64
+}
+
+func (m *memoStore) Load() (string, error) {
+	memo, err := m.fs.GetFile(m.id)
+	if err != nil {
+		return "", err
+	}
+	return memo.Content, nil
+}
+
+func (m *memoStore) MaxBytes() int64 {
+	return m.maxBytes
+}
+
+func (m *memoStore) Save(content string) error {
+	// Memo files cannot be empty; the document is saved again once it has text.
+	if content == "" {
+		return nil
+	}
+
+	updated, err := m.fs.UpdateFile(&model.Memo{ID: m.id, Content: content})
+	if err != nil {
+		return err
+	}
+	m.service.recordLinks(m.ctx, m.fs, updated)
+	m.service.recordHistory(m.ctx, fmt.Sprintf("Edit memo %s: %s", updated.ID, updated.Title))
+	return nil
+}
+
+func convertEventToProto(memoID string, event collab.Event) *grpcPkg.EditOp {
+	op := &grpcPkg.EditOp{
+		MemoId:   memoID,
+		Revision: int64(event.Revision),
+		ClientId: event.ClientID,
+		Name:     event.Name,
+	}
+
+	switch event.Kind {
+	case collab.EventSnapshot:
+		participants := make([]*grpcPkg.EditParticipant, 0, len(event.Peers))
+		for _, peer := range event.Peers {
+			participants = append(participants, &grpcPkg.EditParticipant{
+				ClientId: peer.ClientID,
+				Name:     peer.Name,
+				Cursor:   convertCursorToProto(peer.Cursor),
+			})
+		}
+		op.Payload = &grpcPkg.EditOp_Snapshot{Snapshot: &grpcPkg.EditSnapshot{
+			Content:      event.Content,
+			Participants: participants,
+		}}
+	case collab.EventOperation:
+		op.Payload = &grpcPkg.EditOp_Operation{Operation: convertOperationToProto(event.Operation)}
+	case collab.EventCursor:
+		op.Payload = &grpcPkg.EditOp_Cursor{Cursor: convertCursorToProto(event.Cursor)}
+	case collab.EventPresence:
+		op.Payload = &grpcPkg.EditOp_Presence{Presence: &grpcPkg.EditPresence{Online: event.Online}}
+	}
+	return op
+}
+
+func convertOperationToProto(operation collab.Operation) *grpcPkg.TextOperation {
+	components := make([]*grpcPkg.TextComponent, 0, len(operation))
+	for _, c := range operation {
+		component := &grpcPkg.TextComponent{}
+		switch {
+		case c.Retain > 0:
+			component.Component = &grpcPkg.TextComponent_Retain{Retain: int32(c.Retain)}
+		case c.Insert != "":
+			component.Component = &grpcPkg.TextComponent_Insert{Insert: c.Insert}
+		default:
+			component.Component = &grpcPkg.TextComponent_Delete{Delete: int32(c.Delete)}
+		}
+		components = append(components, component)
+	}
+	return &grpcPkg.TextOperation{Components: components}
+}
+
+// convertOperationFromProto converts a client operation. Empty components are
+// kept so that collab rejects them as invalid.
+func convertOperationFromProto(operation *grpcPkg.TextOperation) collab.Operation {
+	result := make(collab.Operation, 0, len(operation.GetComponents()))
+	for _, c := range operation.GetComponents() {
+		switch component := c.Component.(type) {
+		case *grpcPkg.TextComponent_Retain:
+			result = append(result, collab.Component{Retain: int(component.Retain)})
+		case *grpcPkg.TextComponent_Insert:
+			result = append(result, collab.Component{Insert: component.Insert})
+		case *grpcPkg.TextComponent_Delete:
+			result = append(result, collab.Component{Delete: int(component.Delete)})
+		default:
+			result = append(result, collab.Component{})
+		}
+	}
+	return result
+}
+
+func convertCursorToProto(cursor collab.Cursor) *grpcPkg.EditCursor {
+	return &grpcPkg.EditCursor{
+		Position:     int32(cursor.Position),
+		SelectionEnd: int32(cursor.SelectionEnd),
+	}
+}
+
+func convertCursorFromProto(cursor *grpcPkg.EditCursor) collab.Cursor {
+	return collab.Cursor{
+		Position:     int(cursor.GetPosition()),
+		SelectionEnd: int(cursor.GetSelectionEnd()),
+	}
+}
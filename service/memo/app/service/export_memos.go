@@ -0,0 +1,93 @@
+package service
+
+import (
+	"fmt"
+	"memo/archive"
+	"memo/db/model"
+	grpcPkg "memo/grpc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// archiveChunkSize is the maximum payload of a single ArchiveChunk.
+const archiveChunkSize = 64 * 1024
+
+func (s *MemoService) ExportMemos(req *grpcPkg.ExportRequest, stream grpcPkg.MemoService_ExportMemosServer) error {
+	format, err := archiveFormatFromProto(req.Format)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	fs, err := s.files(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	var memos []*model.Memo
+	if len(req.MemoIds) == 0 {
+		memos, err = fs.ListFiles()
+		if err != nil {
+			return fileServiceError(err, "failed to list memos")
+		}
+	} else {
+		for _, id := range req.MemoIds {
+			memo, err := fs.GetFile(id)
+			if err != nil {
+				return fileServiceError(err, "failed to get memo")
+			}
+			memos = append(memos, memo)
+		}
+	}
+
+	w := &chunkWriter{
+		send: func(data []byte) error {
+			return stream.Send(&grpcPkg.ArchiveChunk{Data: data, Format: req.Format})
+		},
+	}
+	if err := archive.Write(w, format, memos); err != nil {
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+
+	return w.Flush()
+}
+
+// chunkWriter buffers archive bytes and sends them in archiveChunkSize pieces.
+type chunkWriter struct {
+	buf  []byte
+	send func(data []byte) error
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for len(w.buf) >= archiveChunkSize {
+		if err := w.send(w.buf[:archiveChunkSize]); err != nil {
+			return 0, err
+		}
+		w.buf = append([]byte(nil), w.buf[archiveChunkSize:]...)
+	}
+	return len(p), nil
+}
+
+// Flush sends whatever is left in the buffer.
+func (w *chunkWriter) Flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	if err := w.send(w.buf); err != nil {
+		return err
+	}
+	w.buf = nil
+	return nil
+}
+
+func archiveFormatFromProto(format grpcPkg.ArchiveFormat) (archive.Format, error) {
+	switch format {
+	case grpcPkg.ArchiveFormat_ARCHIVE_FORMAT_UNSPECIFIED, grpcPkg.ArchiveFormat_ARCHIVE_FORMAT_TAR_GZ:
+		return archive.FormatTarGz, nil
+	case grpcPkg.ArchiveFormat_ARCHIVE_FORMAT_ZIP:
+		return archive.FormatZip, nil
+	default:
+		return "", fmt.Errorf("unsupported archive format: %v", format)
+	}
+}
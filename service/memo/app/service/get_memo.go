@@ -2,26 +2,24 @@ package service
 
 import (
 	"context"
-	"fmt"
 	grpcPkg "memo/grpc"
-
-	timePkg "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *MemoService) GetMemo(ctx context.Context, req *grpcPkg.GetMemoRequest) (*grpcPkg.GetMemoResponse, error) {
-	memo, err := s.FileService.GetFile(req.Id)
+	fs, err := s.files(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memo, err := fs.GetFile(req.Id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get memo %w", err)
+		return nil, fileServiceError(err, "failed to get memo")
 	}
 
+	grpcMemo := convertMemoToProto(memo)
+	s.attachReminders(ctx, grpcMemo)
 	return &grpcPkg.GetMemoResponse{
-		Memo: &grpcPkg.Memo{
-			Id:        memo.ID,
-			Title:     memo.Title,
-			Content:   memo.Content,
-			CreatedAt: timePkg.New(memo.CreatedAt),
-			UpdatedAt: timePkg.New(memo.UpdatedAt),
-		},
+		Memo: grpcMemo,
 	}, nil
 
 }
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	grpcPkg "memo/grpc"
+)
+
+func (s *MemoService) GetMemoGraph(ctx context.Context, req *grpcPkg.GetMemoGraphRequest) (*grpcPkg.GetMemoGraphResponse, error) {
+	fs, err := s.files(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := s.linkGraph(ctx, fs)
+	if err != nil {
+		return nil, fileServiceError(err, "failed to load links")
+	}
+
+	edges := make([]*grpcPkg.MemoEdge, 0)
+	for _, edge := range g.Edges() {
+		edges = append(edges, &grpcPkg.MemoEdge{
+			SourceId: edge.SourceID,
+			TargetId: edge.TargetID,
+		})
+	}
+
+	return &grpcPkg.GetMemoGraphResponse{
+		Nodes: memoRefs(g, g.IDs()),
+		Edges: edges,
+	}, nil
+}
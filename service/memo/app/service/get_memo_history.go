@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	grpcPkg "memo/grpc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *MemoService) GetMemoHistory(ctx context.Context, req *grpcPkg.GetMemoHistoryRequest) (*grpcPkg.GetMemoHistoryResponse, error) {
+	if s.history == nil {
+		return nil, status.Error(codes.FailedPrecondition, "git versioning is not enabled")
+	}
+
+	commits, err := s.history.History(memoPathMatcher(ctx, req.Id), int(req.Limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get memo history: %w", err)
+	}
+
+	grpcCommits := make([]*grpcPkg.MemoCommit, 0, len(commits))
+	for _, commit := range commits {
+		grpcCommits = append(grpcCommits, convertCommitToProto(commit))
+	}
+
+	return &grpcPkg.GetMemoHistoryResponse{Commits: grpcCommits}, nil
+}
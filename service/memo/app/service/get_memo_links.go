@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+	grpcPkg "memo/grpc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *MemoService) GetMemoLinks(ctx context.Context, req *grpcPkg.GetMemoLinksRequest) (*grpcPkg.GetMemoLinksResponse, error) {
+	fs, err := s.files(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := s.linkGraph(ctx, fs)
+	if err != nil {
+		return nil, fileServiceError(err, "failed to load links")
+	}
+
+	if _, ok := g.Title(req.Id); !ok {
+		return nil, status.Errorf(codes.NotFound, "memo %s not found", req.Id)
+	}
+
+	outgoing, backlinks, unresolved := g.Links(req.Id)
+
+	return &grpcPkg.GetMemoLinksResponse{
+		Outgoing:   memoRefs(g, outgoing),
+		Backlinks:  memoRefs(g, backlinks),
+		Unresolved: unresolved,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	grpcPkg "memo/grpc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *MemoService) GetMultiMemos(ctx context.Context, req *grpcPkg.GetMultiMemoRequest) (*grpcPkg.GetMultiMemoResponse, error) {
+	fs, err := s.files(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.MemoIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "memo_ids is required")
+	}
+
+	memos := make([]*grpcPkg.Memo, 0, len(req.MemoIds))
+	for _, id := range req.MemoIds {
+		memo, err := fs.GetFile(id)
+		if err != nil {
+			return nil, fileServiceError(err, fmt.Sprintf("failed to get memo %s", id))
+		}
+		memos = append(memos, convertMemoToProto(memo))
+	}
+
+	s.attachReminders(ctx, memos...)
+	return &grpcPkg.GetMultiMemoResponse{
+		Memos: memos,
+	}, nil
+}
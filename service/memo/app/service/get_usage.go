@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	grpcPkg "memo/grpc"
+)
+
+func (s *MemoService) GetUsage(ctx context.Context, req *grpcPkg.GetUsageRequest) (*grpcPkg.GetUsageResponse, error) {
+	fs, err := s.files(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limits, scope, usageFS := s.quotaScope(ctx, fs)
+	usage, err := usageFS.Usage()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get usage: %w", err)
+	}
+
+	return &grpcPkg.GetUsageResponse{
+		Scope:      scope,
+		MemoCount:  int64(usage.Memos),
+		TotalBytes: usage.Bytes,
+		Limits:     convertQuotaLimitsToProto(limits),
+	}, nil
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"memo/auth"
+	"memo/db"
+	"memo/db/model"
+	grpcPkg "memo/grpc"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	timePkg "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fileServiceError converts a FileService error into a gRPC status error.
+func fileServiceError(err error, msg string) error {
+	switch {
+	case errors.Is(err, db.ErrNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, db.ErrUnreadable), errors.Is(err, db.ErrNotebookNotEmpty):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	case errors.Is(err, db.ErrInvalidPath):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	case errors.Is(err, db.ErrAlreadyExists):
+		return status.Errorf(codes.AlreadyExists, "%s: %v", msg, err)
+	default:
+		return fmt.Errorf("%s: %w", msg, err)
+	}
+}
+
+// files returns the FileService for the caller.
+// Authenticated users get their own namespace; otherwise the shared folder is used.
+func (s *MemoService) files(ctx context.Context) (db.FileService, error) {
+	user, ok := auth.UserFromContext(ctx)
+	if !ok {
+		return s.FileService, nil
+	}
+
+	fs, err := s.FileService.Namespace(user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "failed to resolve memo namespace: %v", err)
+	}
+	return fs, nil
+}
+
+// convertMemoToProto converts a model.Memo into its gRPC representation.
+func convertMemoToProto(memo *model.Memo) *grpcPkg.Memo {
+	return &grpcPkg.Memo{
+		Id:         memo.ID,
+		Title:      memo.Title,
+		Content:    memo.Content,
+		Tags:       memo.Tags,
+		Properties: memo.Properties,
+		Notebook:   memo.Notebook,
+		CreatedAt:  timePkg.New(memo.CreatedAt),
+		UpdatedAt:  timePkg.New(memo.UpdatedAt),
+	}
+}
+
+// validateTitle rejects titles that cannot be encoded in a memo file name,
+// which has the form "<title>_<id>.<ext>".
+func validateTitle(title string) error {
+	if title == "" {
+		return status.Error(codes.InvalidArgument, "title is required")
+	}
+	if strings.ContainsAny(title, `_/\`) || strings.HasPrefix(title, ".") {
+		return status.Errorf(codes.InvalidArgument, "title %q must not contain '_', '/' or '\\' or start with '.'", title)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"log"
+	"memo/auth"
+	"memo/db"
+	"memo/gitstore"
+	grpcPkg "memo/grpc"
+	"path"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	timePkg "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Metadata keys that set the author of a commit.
+const (
+	authorNameKey  = "x-author-name"
+	authorEmailKey = "x-author-email"
+)
+
+// commitAuthor picks the commit author from the authenticated user, then
+// request metadata, then the configured default. The metadata is only read
+// without authentication, so callers cannot commit as another user.
+func (s *MemoService) commitAuthor(ctx context.Context) gitstore.Author {
+	author := s.defaultAuthor
+
+	if user, ok := auth.UserFromContext(ctx); ok {
+		if user.Name != "" {
+			author.Name = user.Name
+		}
+		if user.Email != "" {
+			author.Email = user.Email
+		}
+		return author
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(authorNameKey); len(values) > 0 && values[0] != "" {
+			author.Name = values[0]
+		}
+		if values := md.Get(authorEmailKey); len(values) > 0 && values[0] != "" {
+			author.Email = values[0]
+		}
+	}
+
+	return author
+}
+
+// recordHistory commits the caller's namespace after a write when git versioning
+// is enabled. The write itself already succeeded, so failures are only logged.
+func (s *MemoService) recordHistory(ctx context.Context, message string) {
+	if s.history == nil {
+		return
+	}
+
+	if _, err := s.history.Commit(message, s.commitAuthor(ctx), namespacePathMatcher(ctx)); err != nil {
+		log.Printf("failed to commit memo history: %v", err)
+	}
+}
+
+// recordFolderHistory commits every memo change in the memo folder. It is for
+// admin operations, such as snapshot restores, that span all namespaces.
+func (s *MemoService) recordFolderHistory(ctx context.Context, message string) {
+	if s.history == nil {
+		return
+	}
+
+	if _, err := s.history.Commit(message, s.commitAuthor(ctx), isMemoPath); err != nil {
+		log.Printf("failed to commit memo history: %v", err)
+	}
+}
+
+// isMemoPath reports whether the repository path p is a memo or template file.
+// Other files in the folder, such as stray or temporary files, are not versioned.
+func isMemoPath(p string) bool {
+	return db.IsMemoFileName(path.Base(p))
+}
+
+// namespacePathMatcher matches the memo files in the caller's namespace.
+// Without authentication memo files anywhere in the folder match.
+func namespacePathMatcher(ctx context.Context) func(p string) bool {
+	ns := namespace(ctx)
+	if ns == "" {
+		return isMemoPath
+	}
+	prefix := ns + "/"
+
+	return func(p string) bool {
+		return strings.HasPrefix(p, prefix) && isMemoPath(p)
+	}
+}
+
+// memoPathMatcher matches the repository paths of a memo in the caller's namespace,
+// in any notebook.
+func memoPathMatcher(ctx context.Context, id string) func(p string) bool {
+	prefix := ""
+	if ns := namespace(ctx); ns != "" {
+		prefix = ns + "/"
+	}
+	suffix := "_" + id + "."
+
+	return func(p string) bool {
+		return strings.HasPrefix(p, prefix) && strings.Contains(path.Base(p), suffix) && isMemoPath(p)
+	}
+}
+
+func convertCommitToProto(commit gitstore.Commit) *grpcPkg.MemoCommit {
+	return &grpcPkg.MemoCommit{
+		Hash:        commit.Hash,
+		Message:     commit.Message,
+		AuthorName:  commit.Author.Name,
+		AuthorEmail: commit.Author.Email,
+		CommittedAt: timePkg.New(commit.When),
+	}
+}
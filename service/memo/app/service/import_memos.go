@@ -0,0 +1,149 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"memo/archive"
+	"memo/db"
+	"memo/db/model"
+	grpcPkg "memo/grpc"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxArchiveBytes bounds the size of an uploaded archive, which is buffered
+// in memory before it is read.
+const maxArchiveBytes = 64 << 20
+
+func (s *MemoService) ImportMemos(stream grpcPkg.MemoService_ImportMemosServer) error {
+	fs, err := s.files(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	var data bytes.Buffer
+	var first *grpcPkg.ArchiveChunk
+
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to receive archive chunk: %w", err)
+		}
+		if first == nil {
+			first = chunk
+		}
+		if data.Len()+len(chunk.Data) > maxArchiveBytes {
+			return status.Errorf(codes.ResourceExhausted, "archive exceeds %d bytes", maxArchiveBytes)
+		}
+		data.Write(chunk.Data)
+	}
+
+	if first == nil || data.Len() == 0 {
+		return status.Error(codes.InvalidArgument, "archive is empty")
+	}
+
+	format, err := archive.DetectFormat(data.Bytes())
+	if first.Format != grpcPkg.ArchiveFormat_ARCHIVE_FORMAT_UNSPECIFIED {
+		format, err = archiveFormatFromProto(first.Format)
+	}
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	entries, err := archive.Read(data.Bytes(), format)
+	if errors.Is(err, archive.ErrTooLarge) {
+		return status.Errorf(codes.ResourceExhausted, "failed to read archive: %v", err)
+	}
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to read archive: %v", err)
+	}
+
+	results := make([]*grpcPkg.ImportResult, 0, len(entries))
+	for _, entry := range entries {
+		results = append(results, s.importMemo(stream.Context(), fs, entry, first.ConflictPolicy))
+	}
+	// Imported memos may add or resolve many links, so rebuild the graph lazily.
+	s.links.Invalidate(namespace(stream.Context()))
+	s.recordHistory(stream.Context(), fmt.Sprintf("Import %d memos", len(entries)))
+
+	return stream.SendAndClose(&grpcPkg.ImportMemosResponse{Results: results})
+}
+
+// importMemo stores a single archived memo according to the conflict policy.
+// Memos with unsafe names or over quota fail without stopping the rest of the import.
+func (s *MemoService) importMemo(ctx context.Context, fs db.FileService, entry archive.Entry, policy grpcPkg.ConflictPolicy) *grpcPkg.ImportResult {
+	result := &grpcPkg.ImportResult{
+		Id:    entry.ID,
+		Title: entry.Title,
+	}
+	fail := func(err error) *grpcPkg.ImportResult {
+		result.Status = grpcPkg.ImportStatus_IMPORT_STATUS_FAILED
+		result.Error = status.Convert(err).Message()
+		return result
+	}
+
+	// The manifest names the memo file, so check it like any other title and ID.
+	if err := validateTitle(entry.Title); err != nil {
+		return fail(err)
+	}
+	if err := entry.Validate(); err != nil {
+		return fail(status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	memo := &model.Memo{
+		ID:       entry.ID,
+		FileType: entry.FileType,
+		Title:    entry.Title,
+		Content:  entry.Content,
+		Notebook: entry.Notebook,
+	}
+	result.Status = grpcPkg.ImportStatus_IMPORT_STATUS_CREATED
+
+	existing, err := fs.GetFile(memo.ID)
+	switch {
+	case errors.Is(err, db.ErrNotFound):
+		// No conflict.
+	case err != nil:
+		return fail(err)
+	default:
+		switch policy {
+		case grpcPkg.ConflictPolicy_CONFLICT_POLICY_OVERWRITE:
+			// Rewrite the existing file in place, so the memo is kept if the
+			// write fails. It keeps its notebook and file type.
+			err := s.writeWithQuota(ctx, fs, contentChange(memo.Content, existing.Content, false), func() error {
+				_, err := fs.UpdateFile(memo)
+				return err
+			})
+			if err != nil {
+				return fail(err)
+			}
+			result.Status = grpcPkg.ImportStatus_IMPORT_STATUS_OVERWRITTEN
+			return result
+		case grpcPkg.ConflictPolicy_CONFLICT_POLICY_RENAME:
+			memo.ID = uuid.New().String()
+			result.NewId = memo.ID
+			result.Status = grpcPkg.ImportStatus_IMPORT_STATUS_RENAMED
+		default:
+			result.Status = grpcPkg.ImportStatus_IMPORT_STATUS_SKIPPED
+			return result
+		}
+	}
+
+	err = s.writeWithQuota(ctx, fs, contentChange(memo.Content, "", true), func() error {
+		_, err := fs.CreateFile(memo)
+		return err
+	})
+	if err != nil {
+		return fail(err)
+	}
+
+	return result
+}
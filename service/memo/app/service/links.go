@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"memo/auth"
+	"memo/db"
+	"memo/db/model"
+	grpcPkg "memo/grpc"
+	"memo/link"
+	"memo/quota"
+)
+
+// namespace returns the key that identifies the caller's memos.
+func namespace(ctx context.Context) string {
+	if user, ok := auth.UserFromContext(ctx); ok {
+		return user.ID
+	}
+	return ""
+}
+
+// linkGraph returns the link graph of the caller's memos, building it on first use.
+func (s *MemoService) linkGraph(ctx context.Context, fs db.FileService) (*link.Graph, error) {
+	return s.links.Graph(namespace(ctx), func(g *link.Graph) error {
+		memos, err := fs.ListFiles()
+		if err != nil {
+			return fmt.Errorf("failed to list memos: %w", err)
+		}
+		for _, memo := range memos {
+			g.Update(memo.ID, memo.Title, memo.Content)
+		}
+		return nil
+	})
+}
+
+// recordLinks updates the link graph after a memo has been written.
+// The memo itself is already stored, so failures are only logged.
+func (s *MemoService) recordLinks(ctx context.Context, fs db.FileService, memo *model.Memo) {
+	g, err := s.linkGraph(ctx, fs)
+	if err != nil {
+		log.Printf("failed to update links of memo %s: %v", memo.ID, err)
+		return
+	}
+	g.Update(memo.ID, memo.Title, memo.Content)
+}
+
+// linkRewrite is a memo whose [[links]] to a renamed memo are rewritten.
+type linkRewrite struct {
+	source  *model.Memo
+	content string
+}
+
+// planLinkRewrites returns the memos that link to the memo id as [[oldTitle]],
+// with their content rewritten to link to newTitle. Nothing is written, so the
+// rewrites can be checked against the quota before the rename.
+func (s *MemoService) planLinkRewrites(ctx context.Context, fs db.FileService, id, oldTitle, newTitle string) ([]linkRewrite, error) {
+	g, err := s.linkGraph(ctx, fs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Backlinks must be resolved while the graph still knows the old title.
+	_, backlinks, _ := g.Links(id)
+
+	rewrites := make([]linkRewrite, 0, len(backlinks))
+	for _, sourceID := range backlinks {
+		source, err := fs.GetFile(sourceID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get linking memo %s: %w", sourceID, err)
+		}
+
+		content := link.RewriteTarget(source.Content, oldTitle, newTitle)
+		if content == source.Content {
+			continue
+		}
+		rewrites = append(rewrites, linkRewrite{source: source, content: content})
+	}
+	return rewrites, nil
+}
+
+// linkRewritesChange is the quota change of writing the rewrites.
+func linkRewritesChange(rewrites []linkRewrite) quota.Change {
+	var change quota.Change
+	for _, rewrite := range rewrites {
+		change = change.Add(contentChange(rewrite.content, rewrite.source.Content, false))
+	}
+	return change
+}
+
+// renameLinks writes the planned link rewrites once the memo has been renamed,
+// then records the renamed memo and the rewritten ones in the link graph.
+func (s *MemoService) renameLinks(ctx context.Context, fs db.FileService, renamed *model.Memo, rewrites []linkRewrite) error {
+	g, err := s.linkGraph(ctx, fs)
+	if err != nil {
+		return err
+	}
+	g.Update(renamed.ID, renamed.Title, renamed.Content)
+
+	for _, rewrite := range rewrites {
+		updated, err := fs.UpdateFile(&model.Memo{
+			ID:       rewrite.source.ID,
+			FileType: rewrite.source.FileType,
+			Title:    rewrite.source.Title,
+			Content:  rewrite.content,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to rewrite links in memo %s: %w", rewrite.source.ID, err)
+		}
+		g.Update(updated.ID, updated.Title, updated.Content)
+	}
+
+	return nil
+}
+
+// memoRefs converts memo IDs into MemoRefs using the titles known to the graph.
+func memoRefs(g *link.Graph, ids []string) []*grpcPkg.MemoRef {
+	refs := make([]*grpcPkg.MemoRef, 0, len(ids))
+	for _, id := range ids {
+		title, _ := g.Title(id)
+		refs = append(refs, &grpcPkg.MemoRef{Id: id, Title: title})
+	}
+	return refs
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+	"memo/db"
+	grpcPkg "memo/grpc"
+)
+
+func (s *MemoService) ListMemoTags(ctx context.Context, req *grpcPkg.ListMemoTagsRequest) (*grpcPkg.ListMemoTagsResponse, error) {
+	fs, err := s.files(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memos, err := fs.ListFiles()
+	if err != nil {
+		return nil, fileServiceError(err, "failed to list memos")
+	}
+
+	tags := make([]*grpcPkg.TagCount, 0)
+	for _, tag := range db.CountTags(memos) {
+		tags = append(tags, &grpcPkg.TagCount{
+			Tag:   tag.Tag,
+			Count: int32(tag.Count),
+		})
+	}
+
+	return &grpcPkg.ListMemoTagsResponse{Tags: tags}, nil
+}
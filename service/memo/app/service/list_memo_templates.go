@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+	grpcPkg "memo/grpc"
+	"sort"
+)
+
+func (s *MemoService) ListMemoTemplates(ctx context.Context, req *grpcPkg.ListMemoTemplatesRequest) (*grpcPkg.ListMemoTemplatesResponse, error) {
+	fs, err := s.files(ctx)
+	if err != nil {
+		return nil, err
+	}
+	templates, err := fs.Templates().ListTemplates()
+	if err != nil {
+		return nil, fileServiceError(err, "failed to list templates")
+	}
+
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Title < templates[j].Title
+	})
+
+	grpcTemplates := make([]*grpcPkg.MemoTemplate, 0, len(templates))
+	for _, tmpl := range templates {
+		grpcTemplates = append(grpcTemplates, convertTemplateToProto(tmpl))
+	}
+
+	return &grpcPkg.ListMemoTemplatesResponse{Templates: grpcTemplates}, nil
+}
@@ -2,28 +2,31 @@ package service
 
 import (
 	"context"
-	"fmt"
 	grpcPkg "memo/grpc"
-
-	timePkg "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *MemoService) ListMemos(ctx context.Context, req *grpcPkg.ListMemosRequest) (*grpcPkg.ListMemosResponse, error) {
-	memos, err := s.FileService.ListFiles()
+	fs, err := s.files(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memos, err := fs.ListFiles()
 	if err != nil {
-		return nil, fmt.Errorf("failed to list memos: %w", err)
+		return nil, fileServiceError(err, "failed to list memos")
 	}
 
 	grpcMemos := make([]*grpcPkg.Memo, 0)
 	for _, memo := range memos {
-		grpcMemos = append(grpcMemos, &grpcPkg.Memo{
-			Id:        memo.ID,
-			Title:     memo.Title,
-			Content:   memo.Content,
-			CreatedAt: timePkg.New(memo.CreatedAt),
-			UpdatedAt: timePkg.New(memo.UpdatedAt),
-		})
+		if !memo.HasTags(req.Tags) || !memo.HasProperties(req.Properties) {
+			continue
+		}
+		if req.Notebook != nil && !memo.InNotebook(*req.Notebook, req.Recursive) {
+			continue
+		}
+		grpcMemos = append(grpcMemos, convertMemoToProto(memo))
 	}
 
+	s.attachReminders(ctx, grpcMemos...)
 	return &grpcPkg.ListMemosResponse{Memos: grpcMemos}, nil
 }
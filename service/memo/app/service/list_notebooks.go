@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	grpcPkg "memo/grpc"
+)
+
+func (s *MemoService) ListNotebooks(ctx context.Context, req *grpcPkg.ListNotebooksRequest) (*grpcPkg.ListNotebooksResponse, error) {
+	fs, err := s.files(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	notebooks, err := fs.ListNotebooks()
+	if err != nil {
+		return nil, fileServiceError(err, "failed to list notebooks")
+	}
+
+	grpcNotebooks := make([]*grpcPkg.Notebook, 0, len(notebooks))
+	for _, notebook := range notebooks {
+		grpcNotebooks = append(grpcNotebooks, &grpcPkg.Notebook{
+			Path:      notebook.Path,
+			MemoCount: int32(notebook.MemoCount),
+		})
+	}
+
+	return &grpcPkg.ListNotebooksResponse{Notebooks: grpcNotebooks}, nil
+}
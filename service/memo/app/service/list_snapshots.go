@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+	grpcPkg "memo/grpc"
+)
+
+func (s *AdminService) ListSnapshots(ctx context.Context, req *grpcPkg.ListSnapshotsRequest) (*grpcPkg.ListSnapshotsResponse, error) {
+	snapshots, err := s.snapshotManager()
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := snapshots.List()
+	if err != nil {
+		return nil, snapshotError(err, "failed to list snapshots")
+	}
+
+	grpcSnapshots := make([]*grpcPkg.Snapshot, 0, len(list))
+	for i := range list {
+		grpcSnapshots = append(grpcSnapshots, convertSnapshotToProto(&list[i]))
+	}
+
+	return &grpcPkg.ListSnapshotsResponse{Snapshots: grpcSnapshots}, nil
+}
@@ -1,19 +1,83 @@
 package service
 
 import (
+	"context"
+	"memo/collab"
 	config "memo/config/server"
 	"memo/db"
+	"memo/gitstore"
 	pb "memo/grpc"
+	"memo/link"
+	"memo/reminder"
+	"sync"
+	"time"
 )
 
+// defaultPersistInterval is used when collab.persist_interval is not configured.
+const defaultPersistInterval = 10 * time.Second
+
 type MemoService struct {
 	pb.UnimplementedMemoServiceServer
+	// FileService is the shared memo folder. Handlers should go through files(ctx),
+	// which scopes it to the authenticated user.
 	db.FileService
+	links *link.Index
+	// history is nil unless git versioning is enabled.
+	history       *gitstore.Repository
+	defaultAuthor gitstore.Author
+	// sessions holds the live EditSession documents.
+	sessions  *collab.Hub
+	reminders *reminder.Scheduler
+	quota     config.Quota
+	// quotaMu serializes the writes that are checked against the quota.
+	quotaMu sync.Mutex
 }
 
-func NewMemoService(env *config.Config) *MemoService {
-	fs, _ := db.GetService(env)
+func NewMemoService(env *config.Config) (*MemoService, error) {
+	fs, err := db.GetService(env)
+	if err != nil {
+		return nil, err
+	}
+
+	var history *gitstore.Repository
+	if env.Git.Enabled {
+		history, err = gitstore.Open(env.FolderPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	reminders, err := reminder.Open(env.FolderPath)
+	if err != nil {
+		return nil, err
+	}
+
+	persistInterval := env.Collab.PersistInterval
+	if persistInterval <= 0 {
+		persistInterval = defaultPersistInterval
+	}
+
 	return &MemoService{
 		FileService: fs,
-	}
+		links:       link.NewIndex(),
+		sessions:    collab.NewHub(persistInterval),
+		reminders:   reminders,
+		quota:       env.Quota,
+		history:     history,
+		defaultAuthor: gitstore.Author{
+			Name:  env.Git.AuthorName,
+			Email: env.Git.AuthorEmail,
+		},
+	}, nil
+}
+
+// RunReminders delivers reminders as they fall due until ctx is done.
+func (s *MemoService) RunReminders(ctx context.Context) {
+	s.reminders.Run(ctx)
+}
+
+// Close ends reminder streams and saves the documents of open edit sessions.
+func (s *MemoService) Close() {
+	s.reminders.Close()
+	s.sessions.Close()
 }
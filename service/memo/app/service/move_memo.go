@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	grpcPkg "memo/grpc"
+)
+
+func (s *MemoService) MoveMemo(ctx context.Context, req *grpcPkg.MoveMemoRequest) (*grpcPkg.MoveMemoResponse, error) {
+	fs, err := s.files(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memo, err := fs.MoveFile(req.Id, req.Notebook)
+	if err != nil {
+		return nil, fileServiceError(err, "failed to move memo")
+	}
+	s.recordHistory(ctx, fmt.Sprintf("Move memo %s to %q", memo.ID, memo.Notebook))
+
+	return &grpcPkg.MoveMemoResponse{
+		Memo: convertMemoToProto(memo),
+	}, nil
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"memo/auth"
+	config "memo/config/server"
+	"memo/db"
+	grpcPkg "memo/grpc"
+	"memo/quota"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// quotaScope returns the limits that apply to the caller, the name of what
+// they apply to, and the FileService whose usage counts against them.
+// fs is the caller's FileService from files(ctx).
+func (s *MemoService) quotaScope(ctx context.Context, fs db.FileService) (quota.Limits, string, db.FileService) {
+	user, ok := auth.UserFromContext(ctx)
+	if !s.quota.PerUser || !ok {
+		return convertQuotaLimits(s.quota.QuotaLimits), "folder", s.FileService
+	}
+
+	limits := s.quota.QuotaLimits
+	// viper lowercases map keys.
+	if override, ok := s.quota.Users[strings.ToLower(user.ID)]; ok {
+		limits = override
+	}
+	return convertQuotaLimits(limits), "user:" + user.ID, fs
+}
+
+// checkQuota returns a ResourceExhausted error if change would exceed the
+// caller's limits.
+func (s *MemoService) checkQuota(ctx context.Context, fs db.FileService, change quota.Change) error {
+	limits, scope, usageFS := s.quotaScope(ctx, fs)
+	if limits.Unlimited() {
+		return nil
+	}
+
+	var usage quota.Usage
+	if limits.MaxMemos > 0 || limits.MaxTotalBytes > 0 {
+		current, err := usageFS.Usage()
+		if err != nil {
+			return fmt.Errorf("failed to get usage: %w", err)
+		}
+		usage = quota.Usage{Memos: current.Memos, Bytes: current.Bytes}
+	}
+
+	if violations := limits.Check(usage, change); len(violations) > 0 {
+		return quotaError(scope, violations)
+	}
+	return nil
+}
+
+// writeWithQuota runs write if change stays within the caller's limits. The
+// check and the write hold the quota lock together, so that concurrent writes
+// cannot exceed the limits between them. An empty change, such as a rename
+// that keeps the content, is not checked. write returns gRPC errors.
+func (s *MemoService) writeWithQuota(ctx context.Context, fs db.FileService, change quota.Change, write func() error) error {
+	if limits, _, _ := s.quotaScope(ctx, fs); !limits.Unlimited() && change != (quota.Change{}) {
+		s.quotaMu.Lock()
+		defer s.quotaMu.Unlock()
+
+		if err := s.checkQuota(ctx, fs, change); err != nil {
+			return err
+		}
+	}
+	return write()
+}
+
+// quotaError builds a ResourceExhausted status with a QuotaFailure detail.
+func quotaError(scope string, violations []quota.Violation) error {
+	failure := &errdetails.QuotaFailure{}
+	messages := make([]string, 0, len(violations))
+	for _, v := range violations {
+		description := fmt.Sprintf("%s quota exceeded: %s", v.Metric, v.Description)
+		failure.Violations = append(failure.Violations, &errdetails.QuotaFailure_Violation{
+			Subject:     scope,
+			Description: description,
+		})
+		messages = append(messages, description)
+	}
+
+	st := status.New(codes.ResourceExhausted, strings.Join(messages, "; "))
+	if detailed, err := st.WithDetails(failure); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// contentChange is the quota change of writing content over previous, which
+// is empty for new memos.
+func contentChange(content, previous string, newMemo bool) quota.Change {
+	change := quota.Change{
+		ContentBytes: int64(len(content)),
+		Bytes:        int64(len(content) - len(previous)),
+	}
+	if newMemo {
+		change.Memos = 1
+	}
+	return change
+}
+
+func convertQuotaLimits(limits config.QuotaLimits) quota.Limits {
+	return quota.Limits{
+		MaxContentBytes: limits.MaxContentBytes,
+		MaxMemos:        limits.MaxMemos,
+		MaxTotalBytes:   limits.MaxTotalBytes,
+	}
+}
+
+func convertQuotaLimitsToProto(limits quota.Limits) *grpcPkg.QuotaLimits {
+	return &grpcPkg.QuotaLimits{
+		MaxContentBytes: limits.MaxContentBytes,
+		MaxMemos:        int64(limits.MaxMemos),
+		MaxTotalBytes:   limits.MaxTotalBytes,
+	}
+}
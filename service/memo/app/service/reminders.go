@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	grpcPkg "memo/grpc"
+	"memo/reminder"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	timePkg "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// reminderError converts a reminder error into a gRPC status error.
+func reminderError(err error, msg string) error {
+	if errors.Is(err, reminder.ErrNotFound) {
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
+
+// validateRemindAt rejects timestamps that cannot be converted to time.Time.
+func validateRemindAt(remindAt *timePkg.Timestamp) error {
+	if err := remindAt.CheckValid(); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid remind_at: %v", err)
+	}
+	return nil
+}
+
+// attachReminders sets RemindAt on memos of the caller that have a reminder.
+func (s *MemoService) attachReminders(ctx context.Context, memos ...*grpcPkg.Memo) {
+	ns := namespace(ctx)
+	for _, memo := range memos {
+		if r, ok := s.reminders.Get(ns, memo.Id); ok {
+			memo.RemindAt = timePkg.New(r.RemindAt)
+		}
+	}
+}
+
+func convertReminderToProto(r reminder.Reminder, memo *grpcPkg.Memo) *grpcPkg.Reminder {
+	res := &grpcPkg.Reminder{
+		MemoId:   r.MemoID,
+		RemindAt: timePkg.New(r.RemindAt),
+		Memo:     memo,
+	}
+	if r.DeliveredAt != nil {
+		res.DeliveredAt = timePkg.New(*r.DeliveredAt)
+	}
+	return res
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	grpcPkg "memo/grpc"
+	"memo/snapshot"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *AdminService) RestoreSnapshot(ctx context.Context, req *grpcPkg.RestoreSnapshotRequest) (*grpcPkg.RestoreSnapshotResponse, error) {
+	snapshots, err := s.snapshotManager()
+	if err != nil {
+		return nil, err
+	}
+	if req.SnapshotId == "" {
+		return nil, status.Error(codes.InvalidArgument, "snapshot_id is required")
+	}
+
+	// Memo writes wait for the restore, so that none lands in a folder being replaced.
+	var result *snapshot.RestoreResult
+	err = s.memo.FileService.Exclusive(func() error {
+		result, err = snapshots.Restore(req.SnapshotId, req.MemoIds)
+		return err
+	})
+	if err != nil {
+		return nil, snapshotError(err, "failed to restore snapshot")
+	}
+
+	// Restored memos may link anywhere, in any namespace.
+	s.memo.links.Reset()
+	if len(req.MemoIds) == 0 {
+		s.memo.recordFolderHistory(ctx, fmt.Sprintf("Restore snapshot %s", req.SnapshotId))
+	} else {
+		s.memo.recordFolderHistory(ctx, fmt.Sprintf("Restore memos %s from snapshot %s", strings.Join(req.MemoIds, ", "), req.SnapshotId))
+	}
+
+	res := &grpcPkg.RestoreSnapshotResponse{RestoredPaths: result.Restored}
+	if result.Backup != nil {
+		res.Backup = convertSnapshotToProto(result.Backup)
+	}
+	return res, nil
+}
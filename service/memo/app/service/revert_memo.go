@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"memo/gitstore"
+	grpcPkg "memo/grpc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *MemoService) RevertMemo(ctx context.Context, req *grpcPkg.RevertMemoRequest) (*grpcPkg.RevertMemoResponse, error) {
+	if s.history == nil {
+		return nil, status.Error(codes.FailedPrecondition, "git versioning is not enabled")
+	}
+
+	fs, err := s.files(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	message := fmt.Sprintf("Revert memo %s to %s", req.Id, req.CommitHash)
+	commit, err := s.history.Restore(req.CommitHash, memoPathMatcher(ctx, req.Id), message, s.commitAuthor(ctx))
+	if errors.Is(err, gitstore.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "failed to revert memo: %v", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to revert memo: %w", err)
+	}
+
+	memo, err := fs.GetFile(req.Id)
+	if err != nil {
+		return nil, fileServiceError(err, "failed to get reverted memo")
+	}
+	// A revert may change the title as well as the content.
+	s.links.Invalidate(namespace(ctx))
+
+	res := &grpcPkg.RevertMemoResponse{Memo: convertMemoToProto(memo)}
+	if commit != nil {
+		res.Commit = convertCommitToProto(*commit)
+	}
+	return res, nil
+}
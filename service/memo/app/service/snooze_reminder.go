@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	grpcPkg "memo/grpc"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *MemoService) SnoozeReminder(ctx context.Context, req *grpcPkg.SnoozeReminderRequest) (*grpcPkg.SnoozeReminderResponse, error) {
+	var until time.Time
+	switch {
+	case req.GetRemindAt() != nil:
+		if err := validateRemindAt(req.GetRemindAt()); err != nil {
+			return nil, err
+		}
+		until = req.GetRemindAt().AsTime()
+	case req.GetDuration() != nil:
+		if err := req.GetDuration().CheckValid(); err != nil || req.GetDuration().AsDuration() <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "duration must be positive")
+		}
+		until = time.Now().Add(req.GetDuration().AsDuration())
+	default:
+		return nil, status.Error(codes.InvalidArgument, "remind_at or duration is required")
+	}
+
+	fs, err := s.files(ctx)
+	if err != nil {
+		return nil, err
+	}
+	memo, err := fs.GetFile(req.MemoId)
+	if err != nil {
+		return nil, fileServiceError(err, "failed to get memo")
+	}
+
+	r, err := s.reminders.Snooze(namespace(ctx), req.MemoId, until)
+	if err != nil {
+		return nil, reminderError(err, "failed to snooze reminder")
+	}
+
+	grpcMemo := convertMemoToProto(memo)
+	s.attachReminders(ctx, grpcMemo)
+	return &grpcPkg.SnoozeReminderResponse{
+		Reminder: convertReminderToProto(r, grpcMemo),
+	}, nil
+}
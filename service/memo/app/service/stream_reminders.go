@@ -0,0 +1,55 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"memo/db"
+	grpcPkg "memo/grpc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *MemoService) StreamReminders(req *grpcPkg.StreamRemindersRequest, stream grpcPkg.MemoService_StreamRemindersServer) error {
+	ctx := stream.Context()
+	fs, err := s.files(ctx)
+	if err != nil {
+		return err
+	}
+
+	ns := namespace(ctx)
+	sub := s.reminders.Subscribe(ns)
+	defer s.reminders.Unsubscribe(sub)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case r, ok := <-sub.C():
+			if !ok {
+				return status.Error(codes.Unavailable, "server is shutting down")
+			}
+
+			var memo *grpcPkg.Memo
+			found, err := fs.GetFile(r.MemoID)
+			switch {
+			case errors.Is(err, db.ErrNotFound):
+				// The memo is gone, so its reminder is too.
+				if err := s.reminders.Dismiss(ns, r.MemoID); err != nil {
+					log.Printf("failed to dismiss reminder of deleted memo %s: %v", r.MemoID, err)
+				}
+				continue
+			case err != nil:
+				log.Printf("failed to get memo %s for its reminder: %v", r.MemoID, err)
+			default:
+				memo = convertMemoToProto(found)
+				s.attachReminders(ctx, memo)
+			}
+
+			if err := stream.Send(convertReminderToProto(r, memo)); err != nil {
+				return fmt.Errorf("failed to send reminder: %w", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"memo/auth"
+	"memo/db/model"
+	grpcPkg "memo/grpc"
+	"strings"
+	"text/template"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	timePkg "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// templateFuncs are the functions available in memo templates.
+var templateFuncs = template.FuncMap{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"trim":  strings.TrimSpace,
+	// default returns fallback when value is empty: {{default "none" (index . "owner")}}.
+	"default": func(fallback, value string) string {
+		if value == "" {
+			return fallback
+		}
+		return value
+	},
+}
+
+// parseTemplate parses the content of a memo template. Unknown variables are
+// errors, so that typos do not silently render as empty text.
+func parseTemplate(name, content string) (*template.Template, error) {
+	tmpl, err := template.New(name).Funcs(templateFuncs).Option("missingkey=error").Parse(content)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid template: %v", err)
+	}
+	return tmpl, nil
+}
+
+// renderTemplate renders a template for a new memo with the built-in and request variables.
+func renderTemplate(ctx context.Context, tmpl *model.Memo, title string, variables map[string]string) (string, error) {
+	parsed, err := parseTemplate(tmpl.Title, tmpl.Content)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	data := map[string]string{
+		"Title":     title,
+		"Date":      now.Format(time.DateOnly),
+		"Time":      now.Format("15:04"),
+		"DateTime":  now.Format("2006-01-02 15:04"),
+		"Weekday":   now.Weekday().String(),
+		"User":      "",
+		"UserEmail": "",
+	}
+	if user, ok := auth.UserFromContext(ctx); ok {
+		data["User"] = user.Name
+		data["UserEmail"] = user.Email
+	}
+	for name, value := range variables {
+		data[name] = value
+	}
+
+	var b strings.Builder
+	if err := parsed.Execute(&b, data); err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "failed to render template %s: %v", tmpl.Title, err)
+	}
+	return b.String(), nil
+}
+
+func convertTemplateToProto(tmpl *model.Memo) *grpcPkg.MemoTemplate {
+	return &grpcPkg.MemoTemplate{
+		Id:        tmpl.ID,
+		Name:      tmpl.Title,
+		Content:   tmpl.Content,
+		CreatedAt: timePkg.New(tmpl.CreatedAt),
+		UpdatedAt: timePkg.New(tmpl.UpdatedAt),
+	}
+}
@@ -2,17 +2,34 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"memo/db/model"
 	grpcPkg "memo/grpc"
+	"memo/reminder"
 
-	timePkg "google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (s *MemoService) UpdateMemo(ctx context.Context, req *grpcPkg.UpdateMemoRequest) (*grpcPkg.UpdateMemoResponse, error) {
-	originMemo, err := s.FileService.GetFile(req.Id)
+	if req.RemindAt != nil {
+		if req.ClearReminder {
+			return nil, status.Error(codes.InvalidArgument, "remind_at and clear_reminder cannot be combined")
+		}
+		if err := validateRemindAt(req.RemindAt); err != nil {
+			return nil, err
+		}
+	}
+
+	fs, err := s.files(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	originMemo, err := fs.GetFile(req.Id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get memo %w", err)
+		return nil, fileServiceError(err, "failed to get memo")
 	}
 
 	updateMemo := &model.Memo{
@@ -22,18 +39,76 @@ func (s *MemoService) UpdateMemo(ctx context.Context, req *grpcPkg.UpdateMemoReq
 		Content:  req.Content,
 	}
 
-	updatedMemo, err := s.FileService.UpdateFile(updateMemo)
-	if err != nil {
-		return nil, fmt.Errorf("failed to update file: %w", err)
+	renamed := req.Title != nil && *req.Title != originMemo.Title
+	if renamed {
+		if err := validateTitle(*req.Title); err != nil {
+			return nil, err
+		}
+		updateMemo.Title = *req.Title
+	}
+	if updateMemo.Content == "" && renamed {
+		updateMemo.Content = originMemo.Content
+	}
+
+	// Content may be left empty when only the reminder changes.
+	reminderOnly := req.Content == "" && !renamed && (req.RemindAt != nil || req.ClearReminder)
+	updatedMemo := originMemo
+	if !reminderOnly {
+		// A rename rewrites the links to the memo in other memos, which are
+		// checked against the quota together with the memo itself.
+		var rewrites []linkRewrite
+		if renamed {
+			rewrites, err = s.planLinkRewrites(ctx, fs, originMemo.ID, originMemo.Title, updateMemo.Title)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update links: %w", err)
+			}
+		}
+
+		// Renames keep the content, so only new content counts against the quota.
+		change := linkRewritesChange(rewrites)
+		if req.Content != "" {
+			change = change.Add(contentChange(req.Content, originMemo.Content, false))
+		}
+		err = s.writeWithQuota(ctx, fs, change, func() (err error) {
+			updatedMemo, err = fs.UpdateFile(updateMemo)
+			if err != nil {
+				return fileServiceError(err, "failed to update file")
+			}
+			if renamed {
+				if err := s.renameLinks(ctx, fs, updatedMemo, rewrites); err != nil {
+					return fmt.Errorf("failed to update links: %w", err)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !renamed {
+			s.recordLinks(ctx, fs, updatedMemo)
+		}
+		// The commit also covers the memos whose links were rewritten.
+		message := fmt.Sprintf("Update memo %s: %s", updatedMemo.ID, updatedMemo.Title)
+		if len(rewrites) > 0 {
+			message += fmt.Sprintf(" (links rewritten in %d memos)", len(rewrites))
+		}
+		s.recordHistory(ctx, message)
+	}
+
+	switch {
+	case req.RemindAt != nil:
+		if _, err := s.reminders.Set(namespace(ctx), updatedMemo.ID, req.RemindAt.AsTime()); err != nil {
+			return nil, fmt.Errorf("failed to set reminder: %w", err)
+		}
+	case req.ClearReminder:
+		if err := s.reminders.Dismiss(namespace(ctx), updatedMemo.ID); err != nil && !errors.Is(err, reminder.ErrNotFound) {
+			return nil, fmt.Errorf("failed to clear reminder: %w", err)
+		}
 	}
 
+	grpcMemo := convertMemoToProto(updatedMemo)
+	s.attachReminders(ctx, grpcMemo)
 	return &grpcPkg.UpdateMemoResponse{
-		Memo: &grpcPkg.Memo{
-			Id:        updatedMemo.ID,
-			Title:     updatedMemo.Title,
-			Content:   updatedMemo.Content,
-			CreatedAt: timePkg.New(updatedMemo.CreatedAt),
-			UpdatedAt: timePkg.New(updatedMemo.UpdatedAt),
-		},
+		Memo: grpcMemo,
 	}, nil
 }
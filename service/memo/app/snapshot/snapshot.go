@@ -0,0 +1,583 @@
+// Package snapshot takes point-in-time backups of the memo folder as tar.gz
+// archives and prunes them with a grandfather-father-son retention policy.
+//
+// Files are archived as stored, so encrypted memos stay encrypted in snapshots.
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	filePrefix = "memo-"
+	fileSuffix = ".tar.gz"
+	// idLayout formats snapshot IDs, which are their creation time in UTC.
+	// Snapshots taken within the same second get a "-<n>" suffix.
+	idLayout = "20060102T150405Z"
+)
+
+var (
+	// ErrNotFound is returned for unknown snapshot IDs.
+	ErrNotFound = errors.New("snapshot not found")
+	// ErrMemoNotFound is returned when a requested memo is not in the snapshot.
+	ErrMemoNotFound = errors.New("memo not found in snapshot")
+)
+
+// Retention is how many snapshots to keep per period. The newest snapshot of
+// each of the last Hourly hours, Daily days and Weekly weeks is kept.
+// When all are zero, no snapshot is pruned.
+type Retention struct {
+	Hourly int
+	Daily  int
+	Weekly int
+}
+
+// Snapshot is an archive in the destination directory.
+type Snapshot struct {
+	ID        string
+	CreatedAt time.Time
+	Path      string
+	Size      int64
+	// seq orders snapshots taken within the same second.
+	seq int
+}
+
+// Manager creates, lists, prunes and restores snapshots of a folder.
+type Manager struct {
+	folder      string
+	destination string
+	retention   Retention
+
+	// mu serializes snapshots and restores so that they never see each other's files.
+	mu sync.Mutex
+}
+
+// New creates a Manager that stores snapshots of folder in destination.
+func New(folder, destination string, retention Retention) *Manager {
+	return &Manager{
+		folder:      folder,
+		destination: destination,
+		retention:   retention,
+	}
+}
+
+// Run takes a snapshot every interval and prunes old ones until ctx is done.
+// The first snapshot is due one interval after the newest existing one.
+func (m *Manager) Run(ctx context.Context, interval time.Duration) {
+	wait := time.Duration(0)
+	if snapshots, err := m.List(); err == nil && len(snapshots) > 0 {
+		wait = time.Until(snapshots[0].CreatedAt.Add(interval))
+	}
+
+	timer := time.NewTimer(max(wait, 0))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		if snapshot, err := m.Create(time.Now()); err != nil {
+			log.Printf("failed to take snapshot: %v", err)
+		} else {
+			log.Printf("took snapshot %s (%d bytes)", snapshot.ID, snapshot.Size)
+		}
+		if removed, err := m.Prune(); err != nil {
+			log.Printf("failed to prune snapshots: %v", err)
+		} else if len(removed) > 0 {
+			log.Printf("pruned %d snapshots", len(removed))
+		}
+
+		timer.Reset(interval)
+	}
+}
+
+// Create archives the folder. Hidden files and directories, such as .git, are left out.
+func (m *Manager) Create(now time.Time) (*Snapshot, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.create(now)
+}
+
+func (m *Manager) create(now time.Time) (*Snapshot, error) {
+	if err := os.MkdirAll(m.destination, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+
+	base := now.UTC().Format(idLayout)
+	id := base
+	target := filepath.Join(m.destination, filePrefix+id+fileSuffix)
+	for seq := 2; ; seq++ {
+		if _, err := os.Stat(target); err != nil {
+			break
+		}
+		id = fmt.Sprintf("%s-%d", base, seq)
+		target = filepath.Join(m.destination, filePrefix+id+fileSuffix)
+	}
+
+	// Write to a temporary file so that a failed snapshot never looks complete.
+	tmp, err := os.CreateTemp(m.destination, ".snapshot-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create snapshot file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := m.writeArchive(tmp); err != nil {
+		tmp.Close()
+		return nil, err
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		return nil, fmt.Errorf("failed to store snapshot: %w", err)
+	}
+
+	info, err := os.Stat(target)
+	if err != nil {
+		return nil, err
+	}
+	return &Snapshot{ID: id, CreatedAt: now.UTC().Truncate(time.Second), Path: target, Size: info.Size()}, nil
+}
+
+func (m *Manager) writeArchive(w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	destination, _ := filepath.Abs(m.destination)
+
+	err := filepath.WalkDir(m.folder, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if p == m.folder && errors.Is(err, os.ErrNotExist) {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if p == m.folder {
+			return nil
+		}
+		// Hidden files, such as the reminders file, belong to the running server.
+		if strings.HasPrefix(entry.Name(), ".") && !entry.IsDir() {
+			return nil
+		}
+		if entry.IsDir() {
+			// Skip hidden directories and the snapshots themselves if they live in the folder.
+			if abs, _ := filepath.Abs(p); strings.HasPrefix(entry.Name(), ".") || abs == destination {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(m.folder, p)
+		if err != nil {
+			return err
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		header := &tar.Header{
+			Name:    filepath.ToSlash(rel),
+			Mode:    int64(info.Mode().Perm()),
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		file, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		_, err = io.Copy(tw, file)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to archive folder: %w", err)
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return nil
+}
+
+// List returns the snapshots, newest first.
+func (m *Manager) List() ([]Snapshot, error) {
+	entries, err := os.ReadDir(m.destination)
+	if errors.Is(err, os.ErrNotExist) {
+		return []Snapshot{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot directory: %w", err)
+	}
+
+	snapshots := make([]Snapshot, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.Type().IsRegular() || !strings.HasPrefix(name, filePrefix) || !strings.HasSuffix(name, fileSuffix) {
+			continue
+		}
+		id := strings.TrimSuffix(strings.TrimPrefix(name, filePrefix), fileSuffix)
+		createdAt, seq, err := parseID(id)
+		if err != nil {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, Snapshot{
+			ID:        id,
+			CreatedAt: createdAt,
+			Path:      filepath.Join(m.destination, name),
+			Size:      info.Size(),
+			seq:       seq,
+		})
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		if !snapshots[i].CreatedAt.Equal(snapshots[j].CreatedAt) {
+			return snapshots[i].CreatedAt.After(snapshots[j].CreatedAt)
+		}
+		return snapshots[i].seq > snapshots[j].seq
+	})
+	return snapshots, nil
+}
+
+// parseID returns the creation time and the same-second sequence number of a snapshot ID.
+func parseID(id string) (time.Time, int, error) {
+	base, suffix, found := strings.Cut(id, "-")
+	seq := 1
+	if found {
+		n, err := strconv.Atoi(suffix)
+		if err != nil || n < 2 || suffix != strconv.Itoa(n) {
+			return time.Time{}, 0, fmt.Errorf("invalid snapshot id: %s", id)
+		}
+		seq = n
+	}
+	createdAt, err := time.Parse(idLayout, base)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	return createdAt, seq, nil
+}
+
+// Prune removes the snapshots the retention policy does not keep and returns them.
+func (m *Manager) Prune() ([]Snapshot, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshots, err := m.List()
+	if err != nil {
+		return nil, err
+	}
+
+	keep := Keep(snapshots, m.retention)
+	removed := make([]Snapshot, 0)
+	for _, snapshot := range snapshots {
+		if keep[snapshot.ID] {
+			continue
+		}
+		if err := os.Remove(snapshot.Path); err != nil {
+			return removed, fmt.Errorf("failed to remove snapshot %s: %w", snapshot.ID, err)
+		}
+		removed = append(removed, snapshot)
+	}
+	return removed, nil
+}
+
+// Keep returns the IDs of the snapshots that the retention policy keeps.
+// snapshots must be sorted newest first. The newest snapshot is always kept.
+func Keep(snapshots []Snapshot, retention Retention) map[string]bool {
+	keep := make(map[string]bool)
+	if len(snapshots) == 0 {
+		return keep
+	}
+	if retention.Hourly == 0 && retention.Daily == 0 && retention.Weekly == 0 {
+		for _, snapshot := range snapshots {
+			keep[snapshot.ID] = true
+		}
+		return keep
+	}
+	keep[snapshots[0].ID] = true
+
+	periods := []struct {
+		count  int
+		bucket func(t time.Time) string
+	}{
+		{retention.Hourly, func(t time.Time) string { return t.Format("2006010215") }},
+		{retention.Daily, func(t time.Time) string { return t.Format("20060102") }},
+		{retention.Weekly, func(t time.Time) string {
+			year, week := t.ISOWeek()
+			return fmt.Sprintf("%d-%02d", year, week)
+		}},
+	}
+
+	for _, period := range periods {
+		seen := make(map[string]bool)
+		for _, snapshot := range snapshots {
+			if len(seen) == period.count {
+				break
+			}
+			bucket := period.bucket(snapshot.CreatedAt.UTC())
+			if seen[bucket] {
+				continue
+			}
+			seen[bucket] = true
+			keep[snapshot.ID] = true
+		}
+	}
+	return keep
+}
+
+// RestoreResult describes a restore.
+type RestoreResult struct {
+	// Restored is the folder-relative path of each restored file.
+	Restored []string
+	// Backup is the snapshot of the folder taken before a full restore.
+	Backup *Snapshot
+}
+
+// Restore restores the snapshot id. Without memo IDs the whole folder is
+// replaced by the snapshot, after taking a backup snapshot of it; hidden
+// entries of the folder are left alone. With memo IDs only those memos are
+// restored, replacing their current files wherever they have moved since.
+func (m *Manager) Restore(id string, memoIDs []string) (*RestoreResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshotPath := filepath.Join(m.destination, filePrefix+id+fileSuffix)
+	if _, _, err := parseID(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	if _, err := os.Stat(snapshotPath); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+
+	if err := os.MkdirAll(m.folder, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create folder: %w", err)
+	}
+	// Extract next to the memos first so that a broken archive leaves them untouched.
+	staging, err := os.MkdirTemp(m.folder, ".restore-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create restore directory: %w", err)
+	}
+	defer os.RemoveAll(staging)
+
+	var match func(name string) bool
+	if len(memoIDs) > 0 {
+		match = func(name string) bool {
+			_, ok := memoIDOf(name, memoIDs)
+			return ok
+		}
+	}
+	extracted, err := extract(snapshotPath, staging, match)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(memoIDs) == 0 {
+		return m.restoreAll(staging, extracted)
+	}
+	return m.restoreMemos(staging, extracted, memoIDs)
+}
+
+func (m *Manager) restoreAll(staging string, extracted []string) (*RestoreResult, error) {
+	backup, err := m.create(time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to back up folder before restoring: %w", err)
+	}
+
+	entries, err := os.ReadDir(m.folder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read folder: %w", err)
+	}
+	destination, _ := filepath.Abs(m.destination)
+	for _, entry := range entries {
+		p := filepath.Join(m.folder, entry.Name())
+		if abs, _ := filepath.Abs(p); strings.HasPrefix(entry.Name(), ".") || abs == destination {
+			continue
+		}
+		if err := os.RemoveAll(p); err != nil {
+			return nil, fmt.Errorf("failed to clear folder: %w", err)
+		}
+	}
+
+	for _, rel := range extracted {
+		if err := move(staging, m.folder, rel); err != nil {
+			return nil, err
+		}
+	}
+	return &RestoreResult{Restored: extracted, Backup: backup}, nil
+}
+
+func (m *Manager) restoreMemos(staging string, extracted []string, memoIDs []string) (*RestoreResult, error) {
+	found := make(map[string]bool)
+	for _, rel := range extracted {
+		id, _ := memoIDOf(path.Base(rel), memoIDs)
+		found[id] = true
+	}
+	for _, id := range memoIDs {
+		if !found[id] {
+			return nil, fmt.Errorf("%w: %s", ErrMemoNotFound, id)
+		}
+	}
+
+	// Remove the current files of the memos, which may have been renamed or moved.
+	err := filepath.WalkDir(m.folder, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if p == m.folder {
+			return nil
+		}
+		if strings.HasPrefix(entry.Name(), ".") {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if entry.Type().IsRegular() {
+			if _, ok := memoIDOf(entry.Name(), memoIDs); ok {
+				return os.Remove(p)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove current memo files: %w", err)
+	}
+
+	for _, rel := range extracted {
+		if err := move(staging, m.folder, rel); err != nil {
+			return nil, err
+		}
+	}
+	return &RestoreResult{Restored: extracted}, nil
+}
+
+// memoIDOf returns which of ids the memo file name "<title>_<id>.<ext>" belongs to.
+func memoIDOf(name string, ids []string) (string, bool) {
+	base := strings.TrimSuffix(name, path.Ext(name))
+	i := strings.LastIndex(base, "_")
+	if i < 0 {
+		return "", false
+	}
+	for _, id := range ids {
+		if base[i+1:] == id {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+// extract writes the regular files of the archive whose base name matches
+// into dir and returns their relative paths. A nil match extracts everything.
+func extract(archivePath, dir string, match func(name string) bool) ([]string, error) {
+	file, err := os.Open(archivePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open snapshot: %w", err)
+	}
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	extracted := make([]string, 0)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read snapshot: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name := path.Clean(header.Name)
+		if !fs.ValidPath(name) || strings.Contains(name, `\`) {
+			return nil, fmt.Errorf("snapshot contains an invalid path: %q", header.Name)
+		}
+		// Snapshots taken before hidden files were left out may still contain them.
+		if isHidden(name) || (match != nil && !match(path.Base(name))) {
+			continue
+		}
+
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return nil, err
+		}
+		out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, fs.FileMode(header.Mode).Perm())
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.Copy(out, tr); err != nil {
+			out.Close()
+			return nil, fmt.Errorf("failed to extract %s: %w", name, err)
+		}
+		if err := out.Close(); err != nil {
+			return nil, err
+		}
+		if err := os.Chtimes(target, header.ModTime, header.ModTime); err != nil {
+			return nil, err
+		}
+		extracted = append(extracted, name)
+	}
+	return extracted, nil
+}
+
+// isHidden reports whether any element of the slash-separated path starts with a dot.
+func isHidden(name string) bool {
+	for _, element := range strings.Split(name, "/") {
+		if strings.HasPrefix(element, ".") {
+			return true
+		}
+	}
+	return false
+}
+
+// move moves the relative path rel from the staging directory into folder.
+func move(staging, folder, rel string) error {
+	target := filepath.Join(folder, filepath.FromSlash(rel))
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return fmt.Errorf("failed to restore %s: %w", rel, err)
+	}
+	if err := os.Rename(filepath.Join(staging, filepath.FromSlash(rel)), target); err != nil {
+		return fmt.Errorf("failed to restore %s: %w", rel, err)
+	}
+	return nil
+}
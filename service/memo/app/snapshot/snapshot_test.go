@@ -0,0 +1,159 @@
+package snapshot
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestKeep(t *testing.T) {
+	start := time.Date(2024, 1, 15, 12, 30, 0, 0, time.UTC) // a Monday
+	var snapshots []Snapshot
+	// One snapshot every 6 hours for 21 days, newest first.
+	for i := 0; i < 21*4; i++ {
+		createdAt := start.Add(-time.Duration(i) * 6 * time.Hour)
+		snapshots = append(snapshots, Snapshot{ID: createdAt.Format(idLayout), CreatedAt: createdAt})
+	}
+
+	keep := Keep(snapshots, Retention{Hourly: 2, Daily: 3, Weekly: 2})
+
+	var got []string
+	for id := range keep {
+		got = append(got, id)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(got)))
+	want := []string{
+		"20240115T123000Z", // newest, hourly, daily, weekly
+		"20240115T063000Z", // hourly
+		"20240114T183000Z", // daily; also the newest of the previous week
+		"20240113T183000Z", // daily
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	if keep := Keep(snapshots, Retention{}); len(keep) != len(snapshots) {
+		t.Errorf("Expected an empty retention to keep all %d snapshots, got %d", len(snapshots), len(keep))
+	}
+}
+
+func TestCreateAndRestore(t *testing.T) {
+	folder := t.TempDir()
+	m := New(folder, filepath.Join(folder, ".snapshots"), Retention{})
+
+	writeFile(t, folder, "Plan_a.md", "v1")
+	writeFile(t, folder, "work/Notes_b.md", "notes v1")
+	writeFile(t, folder, ".git/HEAD", "ref")
+	writeFile(t, folder, ".reminders.json", "reminders v1")
+
+	snapshot, err := m.Create(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	// Rename and move memo b, edit memo a and add memo c.
+	writeFile(t, folder, "Plan_a.md", "v2")
+	os.Remove(filepath.Join(folder, "work/Notes_b.md"))
+	writeFile(t, folder, "archive/Old notes_b.md", "notes v2")
+	writeFile(t, folder, "New_c.md", "new")
+	writeFile(t, folder, ".reminders.json", "reminders v2")
+
+	result, err := m.Restore(snapshot.ID, []string{"b"})
+	if err != nil {
+		t.Fatalf("Restore of a memo failed: %v", err)
+	}
+	if !reflect.DeepEqual(result.Restored, []string{"work/Notes_b.md"}) {
+		t.Errorf("Unexpected restored files: %v", result.Restored)
+	}
+	assertFile(t, folder, "work/Notes_b.md", "notes v1")
+	assertMissing(t, folder, "archive/Old notes_b.md")
+	assertFile(t, folder, "Plan_a.md", "v2")
+
+	if _, err := m.Restore(snapshot.ID, []string{"c"}); err == nil {
+		t.Error("Expected an error for a memo that is not in the snapshot")
+	}
+
+	result, err = m.Restore(snapshot.ID, nil)
+	if err != nil {
+		t.Fatalf("Restore of the folder failed: %v", err)
+	}
+	if result.Backup == nil {
+		t.Error("Expected a backup snapshot before a full restore")
+	}
+	assertFile(t, folder, "Plan_a.md", "v1")
+	assertMissing(t, folder, "New_c.md")
+	assertFile(t, folder, ".git/HEAD", "ref")
+	// The reminders are live server state, so a restore leaves them alone.
+	assertFile(t, folder, ".reminders.json", "reminders v2")
+
+	snapshots, err := m.List()
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(snapshots) != 2 || snapshots[1].ID != snapshot.ID {
+		t.Errorf("Unexpected snapshots: %+v", snapshots)
+	}
+}
+
+func TestCreateWithinTheSameSecond(t *testing.T) {
+	folder := t.TempDir()
+	m := New(folder, filepath.Join(folder, ".snapshots"), Retention{})
+	writeFile(t, folder, "Plan_a.md", "v1")
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	first, err := m.Create(now)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	second, err := m.Create(now.Add(500 * time.Millisecond))
+	if err != nil {
+		t.Fatalf("Second Create in the same second failed: %v", err)
+	}
+	if second.ID != first.ID+"-2" {
+		t.Errorf("Expected ID %s-2, got %s", first.ID, second.ID)
+	}
+
+	snapshots, err := m.List()
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(snapshots) != 2 || snapshots[0].ID != second.ID {
+		t.Errorf("Unexpected snapshots: %+v", snapshots)
+	}
+	if _, err := m.Restore(second.ID, []string{"a"}); err != nil {
+		t.Errorf("Restore of %s failed: %v", second.ID, err)
+	}
+}
+
+func writeFile(t *testing.T, folder, name, content string) {
+	t.Helper()
+	p := filepath.Join(folder, name)
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func assertFile(t *testing.T, folder, name, want string) {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(folder, name))
+	if err != nil {
+		t.Errorf("Expected %s to exist: %v", name, err)
+		return
+	}
+	if string(data) != want {
+		t.Errorf("Expected %s to contain %q, got %q", name, want, data)
+	}
+}
+
+func assertMissing(t *testing.T, folder, name string) {
+	t.Helper()
+	if _, err := os.Stat(filepath.Join(folder, name)); !os.IsNotExist(err) {
+		t.Errorf("Expected %s to be removed", name)
+	}
+}
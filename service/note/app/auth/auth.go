@@ -0,0 +1,210 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	config "note/config/server"
+	authpb "note/grpc/auth"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// User はリクエストを送った認証済みのユーザー
+type User struct {
+	ID    string
+	Email string
+	Name  string
+}
+
+// Verifier は認証サービスが発行したトークンを検証する
+type Verifier interface {
+	Verify(ctx context.Context, token string) (*User, error)
+	Close() error
+}
+
+// NewVerifier は設定されたモードの Verifier を作成する。
+// 認証が無効な場合は nil を返す
+func NewVerifier(cfg config.Auth) (Verifier, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	switch cfg.Mode {
+	case "", "remote":
+		if cfg.Address == "" {
+			return nil, fmt.Errorf("auth address is required in remote mode")
+		}
+		conn, err := grpc.NewClient(cfg.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to auth service: %w", err)
+		}
+		return &remoteVerifier{conn: conn, client: authpb.NewAuthServiceClient(conn)}, nil
+	case "local":
+		if cfg.JWTSecret == "" {
+			return nil, fmt.Errorf("jwt_secret is required in local mode")
+		}
+		return &localVerifier{secretKey: []byte(cfg.JWTSecret)}, nil
+	default:
+		return nil, fmt.Errorf("unknown auth mode: %q", cfg.Mode)
+	}
+}
+
+// remoteVerifier はトークンごとに認証サービスの VerifyToken を呼び出す
+type remoteVerifier struct {
+	conn   *grpc.ClientConn
+	client authpb.AuthServiceClient
+}
+
+func (v *remoteVerifier) Verify(ctx context.Context, token string) (*User, error) {
+	res, err := v.client.VerifyToken(ctx, &authpb.VerifyTokenRequest{Token: token})
+	if err != nil {
+		return nil, err
+	}
+	return &User{ID: res.UserId, Email: res.Email, Name: res.Name}, nil
+}
+
+func (v *remoteVerifier) Close() error {
+	return v.conn.Close()
+}
+
+// userClaims は認証サービスが発行するクレーム
+type userClaims struct {
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+	Name   string `json:"name"`
+	jwt.RegisteredClaims
+}
+
+// localVerifier は認証サービスと共有する秘密鍵でトークンを検証する
+type localVerifier struct {
+	secretKey []byte
+}
+
+func (v *localVerifier) Verify(ctx context.Context, tokenString string) (*User, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &userClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected token signing method")
+		}
+		return v.secretKey, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+
+	claims, ok := token.Claims.(*userClaims)
+	if !ok || claims.UserID == "" {
+		return nil, fmt.Errorf("invalid token claims")
+	}
+
+	return &User{ID: claims.UserID, Email: claims.Email, Name: claims.Name}, nil
+}
+
+func (v *localVerifier) Close() error {
+	return nil
+}
+
+type userKey struct{}
+
+// NewContext は認証済みのユーザーを持つ context を返す
+func NewContext(ctx context.Context, user *User) context.Context {
+	return context.WithValue(ctx, userKey{}, user)
+}
+
+// UserFromContext は認証済みのユーザーを返す
+func UserFromContext(ctx context.Context) (*User, bool) {
+	user, ok := ctx.Value(userKey{}).(*User)
+	return user, ok
+}
+
+// OwnerID はノートの所有者として使うユーザー ID を返す。
+// 認証が無効な場合は空文字で、全員が同じノートを共有する
+func OwnerID(ctx context.Context) string {
+	if user, ok := UserFromContext(ctx); ok {
+		return user.ID
+	}
+	return ""
+}
+
+// UnaryServerInterceptor は有効なベアラートークンのない呼び出しを拒否する
+func UnaryServerInterceptor(v Verifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if skipAuth(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		ctx, err := authenticate(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor は有効なベアラートークンのないストリーミング呼び出しを拒否する
+func StreamServerInterceptor(v Verifier) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if skipAuth(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		ctx, err := authenticate(ss.Context(), v)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// skipAuth は認証なしで呼び出せるメソッドかどうかを返す
+func skipAuth(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/grpc.reflection.")
+}
+
+func authenticate(ctx context.Context, v Verifier) (context.Context, error) {
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := v.Verify(ctx, token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", status.Convert(err).Message())
+	}
+
+	return NewContext(ctx, user), nil
+}
+
+func bearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", status.Error(codes.Unauthenticated, "missing authorization header")
+	}
+
+	token, ok := strings.CutPrefix(values[0], "Bearer ")
+	if !ok || token == "" {
+		return "", status.Error(codes.Unauthenticated, "authorization header must be a bearer token")
+	}
+
+	return token, nil
+}
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	config "note/config/server"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func signToken(t *testing.T, secret string, claims userClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return token
+}
+
+func TestAuthenticate(t *testing.T) {
+	verifier, err := NewVerifier(config.Auth{Enabled: true, Mode: "local", JWTSecret: "test-secret-key"})
+	if err != nil {
+		t.Fatalf("NewVerifier failed: %v", err)
+	}
+	token := signToken(t, "test-secret-key", userClaims{
+		UserID: "test-user-id",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	})
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	ctx, err = authenticate(ctx, verifier)
+	if err != nil {
+		t.Fatalf("authenticate failed: %v", err)
+	}
+	if owner := OwnerID(ctx); owner != "test-user-id" {
+		t.Errorf("Expected owner test-user-id, got %q", owner)
+	}
+
+	expired := signToken(t, "test-secret-key", userClaims{
+		UserID: "test-user-id",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Hour)),
+		},
+	})
+	for _, md := range []metadata.MD{
+		metadata.Pairs(),
+		metadata.Pairs("authorization", token),
+		metadata.Pairs("authorization", "Bearer "+expired),
+		metadata.Pairs("authorization", "Bearer "+signToken(t, "other-secret", userClaims{UserID: "test-user-id"})),
+	} {
+		_, err := authenticate(metadata.NewIncomingContext(context.Background(), md), verifier)
+		if status.Code(err) != codes.Unauthenticated {
+			t.Errorf("Expected Unauthenticated for %v, got %v", md, err)
+		}
+	}
+
+	if owner := OwnerID(context.Background()); owner != "" {
+		t.Errorf("Expected no owner without authentication, got %q", owner)
+	}
+}
@@ -1,13 +1,16 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
+	"note/auth"
 	config "note/config/server"
 	"note/db"
 	pb "note/grpc"
@@ -43,6 +46,19 @@ func main() {
 
 	log.Println("✅ Database connected successfully!")
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// ゴミ箱の保持期間を過ぎたノートを定期的に削除
+	if cfg.Trash.Retention > 0 {
+		interval := cfg.Trash.PurgeInterval
+		if interval <= 0 {
+			interval = time.Hour
+		}
+		go service.PurgeTrash(ctx, database, cfg.Trash.Retention, interval)
+		log.Printf("✅ Trash retention enabled - Retention: %s", cfg.Trash.Retention)
+	}
+
 	// gRPCサーバーの設定
 	port := cfg.Port
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
@@ -50,7 +66,23 @@ func main() {
 		log.Fatalf("❌ Failed to listen on port %d: %v", port, err)
 	}
 
-	grpcServer := grpc.NewServer()
+	// 認証が有効ならトークンを検証し、ノートを呼び出したユーザーのものに限定する
+	verifier, err := auth.NewVerifier(cfg.Auth)
+	if err != nil {
+		log.Fatalf("❌ Failed to set up authentication: %v", err)
+	}
+
+	var opts []grpc.ServerOption
+	if verifier != nil {
+		defer verifier.Close()
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(auth.UnaryServerInterceptor(verifier)),
+			grpc.ChainStreamInterceptor(auth.StreamServerInterceptor(verifier)),
+		)
+		log.Printf("✅ Authentication enabled - Mode: %s", cfg.Auth.Mode)
+	}
+
+	grpcServer := grpc.NewServer(opts...)
 
 	// Note サービスを登録
 	noteServer := service.NewNoteServer(database)
@@ -76,6 +108,7 @@ func main() {
 	<-quit
 
 	log.Println("🛑 Shutting down Note gRPC Server...")
+	cancel()
 	grpcServer.GracefulStop()
 	log.Println("✅ Note gRPC Server stopped gracefully")
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"runtime"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -13,6 +14,8 @@ type Config struct {
 	Port     int      `mapstructure:"port" default:"8082"`
 	Env      string   `mapstructure:"env" default:"development"`
 	Database Database `mapstructure:"database"`
+	Trash    Trash    `mapstructure:"trash"`
+	Auth     Auth     `mapstructure:"auth"`
 }
 
 // Database holds the database configuration
@@ -25,6 +28,21 @@ type Database struct {
 	SSLMode  string `mapstructure:"sslmode" default:"disable"`
 }
 
+// Trash holds the retention settings for deleted notes
+type Trash struct {
+	// Retention is how long deleted notes stay in the trash. 0 keeps them forever
+	Retention     time.Duration `mapstructure:"retention" default:"720h"`
+	PurgeInterval time.Duration `mapstructure:"purge_interval" default:"1h"`
+}
+
+// Auth holds the settings for authenticating requests with auth service tokens
+type Auth struct {
+	Enabled   bool   `mapstructure:"enabled" default:"false"`
+	Mode      string `mapstructure:"mode" default:"remote"`
+	Address   string `mapstructure:"address"`
+	JWTSecret string `mapstructure:"jwt_secret"`
+}
+
 // LoadConfig loads the configuration from a file or environment variables
 func LoadConfig() (*Config, error) {
 	// Set the configuration file name and type
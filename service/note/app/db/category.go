@@ -0,0 +1,244 @@
+package db
+
+import (
+	"fmt"
+	"note/db/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// descendantCategoriesSQL はカテゴリ自身とその子孫の ID を返すサブクエリ
+const descendantCategoriesSQL = `WITH RECURSIVE descendants AS (
+		SELECT id FROM categories WHERE id = ?
+		UNION ALL
+		SELECT categories.id FROM categories JOIN descendants ON categories.parent_id = descendants.id
+	) SELECT id FROM descendants`
+
+// migrateCategories は兄弟で名前が重複しないようにする一意インデックスを作成し、
+// 自由入力のカテゴリをルートのカテゴリに移行する。移行済みのノートは対象外。
+// gen_random_uuid() は PostgreSQL 13 より前では使えないため、ID は Go で生成する
+func migrateCategories(db *gorm.DB) error {
+	// parent_id が NULL のルート同士も比較できるよう COALESCE する
+	err := db.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_sibling_name
+		ON categories (owner_id, COALESCE(parent_id, ''), name)`).Error
+	if err != nil {
+		return fmt.Errorf("failed to migrate categories: %w", err)
+	}
+
+	var legacy []struct {
+		OwnerID  string
+		Category string
+	}
+	err = db.Table("notes").
+		Distinct("owner_id", "category").
+		Where("category <> '' AND category_id IS NULL").
+		Scan(&legacy).Error
+	if err != nil {
+		return fmt.Errorf("failed to migrate categories: %w", err)
+	}
+
+	if len(legacy) > 0 {
+		categories := make([]*model.Category, 0, len(legacy))
+		for _, l := range legacy {
+			categories = append(categories, &model.Category{OwnerID: l.OwnerID, Name: l.Category})
+		}
+		// 既に同じ名前のルートのカテゴリがあればそれを使う
+		if err := db.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(categories, 100).Error; err != nil {
+			return fmt.Errorf("failed to migrate categories: %w", err)
+		}
+	}
+
+	err = db.Exec(`UPDATE notes SET category_id = categories.id
+		FROM categories
+		WHERE notes.category <> '' AND notes.category_id IS NULL
+			AND categories.owner_id = notes.owner_id
+			AND categories.parent_id IS NULL
+			AND categories.name = notes.category`).Error
+	if err != nil {
+		return fmt.Errorf("failed to migrate categories: %w", err)
+	}
+
+	return nil
+}
+
+func (d *db) CreateCategory(tx *gorm.DB, category *model.Category) error {
+	client := d.getClient(tx)
+
+	if category.ParentID != nil {
+		if _, err := d.GetCategory(client, category.OwnerID, *category.ParentID); err != nil {
+			return err
+		}
+	}
+	if err := d.checkSiblingName(client, category.OwnerID, category.ParentID, category.Name, ""); err != nil {
+		return err
+	}
+
+	if err := client.Create(category).Error; err != nil {
+		return fmt.Errorf("failed to create category: %w", err)
+	}
+
+	return nil
+}
+
+func (d *db) GetCategory(tx *gorm.DB, ownerID, id string) (*model.Category, error) {
+	client := d.getClient(tx)
+
+	var category model.Category
+	if err := client.First(&category, "id = ? AND owner_id = ?", id, ownerID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("category with ID %s: %w", id, ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get category by ID %s: %w", id, err)
+	}
+
+	return &category, nil
+}
+
+// ListCategories はユーザーのすべてのカテゴリを名前順に返す
+func (d *db) ListCategories(tx *gorm.DB, ownerID string) ([]*model.Category, error) {
+	client := d.getClient(tx)
+
+	var categories []*model.Category
+	if err := client.Where("owner_id = ?", ownerID).Order("name ASC, id ASC").Find(&categories).Error; err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	return categories, nil
+}
+
+func (d *db) RenameCategory(tx *gorm.DB, ownerID, id, name string) error {
+	client := d.getClient(tx)
+
+	category, err := d.GetCategory(client, ownerID, id)
+	if err != nil {
+		return err
+	}
+	if err := d.checkSiblingName(client, ownerID, category.ParentID, name, id); err != nil {
+		return err
+	}
+
+	if err := client.Model(category).Update("name", name).Error; err != nil {
+		return fmt.Errorf("failed to rename category with ID %s: %w", id, err)
+	}
+
+	// ノートの category もカテゴリ名にそろえる。更新日時とバージョンは変えない
+	err = client.Unscoped().Model(&model.Note{}).Where("category_id = ?", id).
+		UpdateColumn("category", name).Error
+	if err != nil {
+		return fmt.Errorf("failed to rename category of notes in category %s: %w", id, err)
+	}
+
+	return nil
+}
+
+// MoveCategory はカテゴリを parentID の子に移動する。parentID が nil ならルートに移動する。
+// 自身や子孫の下には移動できない
+func (d *db) MoveCategory(tx *gorm.DB, ownerID, id string, parentID *string) error {
+	client := d.getClient(tx)
+
+	category, err := d.GetCategory(client, ownerID, id)
+	if err != nil {
+		return err
+	}
+
+	if parentID != nil {
+		if _, err := d.GetCategory(client, ownerID, *parentID); err != nil {
+			return err
+		}
+
+		var cycles int64
+		err := client.Raw(fmt.Sprintf("SELECT count(*) FROM (%s) AS descendants WHERE id = ?", descendantCategoriesSQL), id, *parentID).
+			Scan(&cycles).Error
+		if err != nil {
+			return fmt.Errorf("failed to check descendants of category %s: %w", id, err)
+		}
+		if cycles > 0 {
+			return fmt.Errorf("cannot move category %s under %s: %w", id, *parentID, ErrCategoryCycle)
+		}
+	}
+
+	if err := d.checkSiblingName(client, ownerID, parentID, category.Name, id); err != nil {
+		return err
+	}
+
+	if err := client.Model(category).Update("parent_id", parentID).Error; err != nil {
+		return fmt.Errorf("failed to move category with ID %s: %w", id, err)
+	}
+
+	return nil
+}
+
+// DeleteCategory はカテゴリを削除する。子カテゴリは削除したカテゴリの親に移り、
+// ノートは reassignTo のカテゴリに付け替える。reassignTo が nil なら未分類にする。
+// ゴミ箱のノートも付け替える
+func (d *db) DeleteCategory(tx *gorm.DB, ownerID, id string, reassignTo *string) error {
+	client := d.getClient(tx)
+
+	category, err := d.GetCategory(client, ownerID, id)
+	if err != nil {
+		return err
+	}
+
+	reassignName := ""
+	if reassignTo != nil {
+		if *reassignTo == id {
+			return fmt.Errorf("cannot reassign notes of category %s to itself: %w", id, ErrCategoryCycle)
+		}
+		target, err := d.GetCategory(client, ownerID, *reassignTo)
+		if err != nil {
+			return err
+		}
+		reassignName = target.Name
+	}
+
+	// 子カテゴリの名前が移動先の兄弟と重複しないか確認する
+	var children []*model.Category
+	if err := client.Where("parent_id = ?", id).Find(&children).Error; err != nil {
+		return fmt.Errorf("failed to list children of category %s: %w", id, err)
+	}
+	for _, child := range children {
+		if err := d.checkSiblingName(client, ownerID, category.ParentID, child.Name, id); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Model(&model.Category{}).Where("parent_id = ?", id).Update("parent_id", category.ParentID).Error; err != nil {
+		return fmt.Errorf("failed to move children of category %s: %w", id, err)
+	}
+
+	// 更新日時とバージョンは変えず、分類だけを付け替える
+	err = client.Unscoped().Model(&model.Note{}).Where("category_id = ?", id).
+		UpdateColumns(map[string]any{"category_id": reassignTo, "category": reassignName}).Error
+	if err != nil {
+		return fmt.Errorf("failed to reassign notes of category %s: %w", id, err)
+	}
+
+	if err := client.Delete(category).Error; err != nil {
+		return fmt.Errorf("failed to delete category with ID %s: %w", id, err)
+	}
+
+	return nil
+}
+
+// checkSiblingName は parentID の子に name のカテゴリがあれば ErrAlreadyExists を返す。
+// excludeID のカテゴリは比較しない
+func (d *db) checkSiblingName(client *gorm.DB, ownerID string, parentID *string, name, excludeID string) error {
+	query := client.Session(&gorm.Session{NewDB: true}).Model(&model.Category{}).
+		Where("owner_id = ? AND name = ? AND id <> ?", ownerID, name, excludeID)
+	if parentID != nil {
+		query = query.Where("parent_id = ?", *parentID)
+	} else {
+		query = query.Where("parent_id IS NULL")
+	}
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check category name %q: %w", name, err)
+	}
+	if count > 0 {
+		return fmt.Errorf("category %q: %w", name, ErrAlreadyExists)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"errors"
 	"fmt"
 	"note/db/model"
 	"time"
@@ -14,14 +15,65 @@ type DB interface {
 	Close() error
 	Ping() error
 	// Note関連のメソッド
+	// ownerID を受け取るメソッドは、そのユーザーのノートだけを対象にする。
+	// 他のユーザーのノートは存在しないノートと同じく ErrNotFound になる
 
 	CreateNote(tx *gorm.DB, note *model.Note) error
-	UpdateNote(tx *gorm.DB, note *model.Note) error
-	DeleteNote(tx *gorm.DB, id string) error
-	GetNoteByID(tx *gorm.DB, id string) (*model.Note, error)
-	ListNotes(tx *gorm.DB, page, limit int32, category string, tags []string) ([]*model.Note, int64, error)
+	UpdateNoteFields(tx *gorm.DB, ownerID, id string, fields map[string]any, expectedVersion int64) error
+	DeleteNote(tx *gorm.DB, ownerID, id string, expectedVersion int64) error
+	GetNoteByID(tx *gorm.DB, ownerID, id string) (*model.Note, error)
+	ListNotes(tx *gorm.DB, params ListNotesParams) ([]*model.Note, int64, error)
+	SearchNotes(tx *gorm.DB, ownerID, query string, page, limit int32, category, categoryID string, tags []string) ([]*SearchResult, int64, error)
+
+	// ゴミ箱関連のメソッド
+
+	ListDeletedNotes(tx *gorm.DB, ownerID string, page, limit int32) ([]*model.Note, int64, error)
+	RestoreNote(tx *gorm.DB, ownerID, id string) error
+	PurgeNote(tx *gorm.DB, ownerID, id string) error
+	// PurgeDeletedNotes は保持期間の処理用で、全ユーザーのノートが対象
+	PurgeDeletedNotes(tx *gorm.DB, before time.Time) (int64, error)
+
+	// 共有関連のメソッド
+
+	GetAccessibleNote(tx *gorm.DB, userID, id string) (*model.Note, model.ShareRole, error)
+	ShareNote(tx *gorm.DB, share *model.NoteShare) error
+	UnshareNote(tx *gorm.DB, noteID, userID string) error
+	ListNoteShares(tx *gorm.DB, noteID string) ([]*model.NoteShare, error)
+	ListSharedWithMe(tx *gorm.DB, userID string, page, limit int32) ([]*SharedNote, int64, error)
+
+	// リビジョン関連のメソッド
+
+	CreateNoteRevision(tx *gorm.DB, revision *model.NoteRevision) error
+	ListNoteRevisions(tx *gorm.DB, noteID string, page, limit int32) ([]*model.NoteRevision, int64, error)
+	GetNoteRevision(tx *gorm.DB, noteID, id string) (*model.NoteRevision, error)
+
+	// カテゴリ関連のメソッド
+
+	CreateCategory(tx *gorm.DB, category *model.Category) error
+	GetCategory(tx *gorm.DB, ownerID, id string) (*model.Category, error)
+	ListCategories(tx *gorm.DB, ownerID string) ([]*model.Category, error)
+	RenameCategory(tx *gorm.DB, ownerID, id, name string) error
+	MoveCategory(tx *gorm.DB, ownerID, id string, parentID *string) error
+	DeleteCategory(tx *gorm.DB, ownerID, id string, reassignTo *string) error
+
+	// タグ関連のメソッド
+
+	ListTags(tx *gorm.DB, ownerID, prefix string, limit int32) ([]*TagCount, error)
+	FindNotesByTags(tx *gorm.DB, ownerID string, tags []string) ([]*model.Note, error)
+	SetNoteTags(tx *gorm.DB, id string, tags []string) error
 }
 
+var (
+	// ErrNotFound は対象のノートやカテゴリが存在しない場合に返される
+	ErrNotFound = errors.New("not found")
+	// ErrVersionMismatch はノートのバージョンが期待したものと異なる場合に返される
+	ErrVersionMismatch = errors.New("note version does not match")
+	// ErrAlreadyExists は同じ親の下に同じ名前のカテゴリがある場合に返される
+	ErrAlreadyExists = errors.New("already exists")
+	// ErrCategoryCycle はカテゴリを自身や子孫の下に移動しようとした場合に返される
+	ErrCategoryCycle = errors.New("category cycle")
+)
+
 type db struct {
 	client *gorm.DB
 }
@@ -83,11 +135,27 @@ func New(cfg Config) (DB, error) {
 // Migrate はデータベースのマイグレーションを実行します。
 func migrateDB(db *gorm.DB) error {
 	if err := db.AutoMigrate(
+		&model.Category{},
 		&model.Note{},
+		&model.NoteShare{},
+		&model.NoteRevision{},
 	); err != nil {
 		return err
 	}
 
+	if err := migrateCategories(db); err != nil {
+		return err
+	}
+
+	if err := migrateTags(db); err != nil {
+		return err
+	}
+
+	// 全文検索用の生成列とインデックスはモデルに含めず SQL で作成する
+	if err := migrateSearch(db); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Category はノートを分類するカテゴリ。親子関係で木構造を作る
+type Category struct {
+	ID string `gorm:"primaryKey;type:varchar(255)" json:"id" db:"id"`
+	// OwnerID はカテゴリを作成したユーザーの ID。認証が無効な場合は空文字
+	OwnerID string `gorm:"type:varchar(255);not null;default:'';index" json:"owner_id" db:"owner_id"`
+	// ParentID は親カテゴリの ID。NULL ならルートのカテゴリ
+	ParentID  *string   `gorm:"type:varchar(255);index" json:"parent_id" db:"parent_id"`
+	Name      string    `gorm:"type:varchar(100);not null" json:"name" db:"name"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at" db:"updated_at"`
+	// Parent は親カテゴリの外部キー。子があるカテゴリは DeleteCategory で付け替えてから削除する
+	Parent *Category `gorm:"foreignKey:ParentID;constraint:OnDelete:RESTRICT" json:"-" db:"-"`
+}
+
+// BeforeCreate はカテゴリ作成前にUUIDを自動生成する
+func (c *Category) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == "" {
+		c.ID = uuid.New().String()
+	}
+	return nil
+}
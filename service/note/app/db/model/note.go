@@ -9,13 +9,24 @@ import (
 )
 
 type Note struct {
-	ID        string         `gorm:"primaryKey;type:varchar(255)" json:"id" db:"id"`
-	Title     string         `gorm:"type:varchar(255);not null" json:"title" db:"title"`
-	Content   string         `gorm:"type:text" json:"content" db:"content"`
-	Category  string         `gorm:"type:varchar(100)" json:"category" db:"category"`
-	Tags      pq.StringArray `gorm:"type:text[]" json:"tags" db:"tags"`
-	CreatedAt time.Time      `gorm:"autoCreateTime" json:"created_at" db:"created_at"`
-	UpdatedAt time.Time      `gorm:"autoUpdateTime" json:"updated_at" db:"updated_at"`
+	ID string `gorm:"primaryKey;type:varchar(255)" json:"id" db:"id"`
+	// OwnerID はノートを作成したユーザーの ID。認証が無効な場合は空文字
+	OwnerID string `gorm:"type:varchar(255);not null;default:'';index" json:"owner_id" db:"owner_id"`
+	Title   string `gorm:"type:varchar(255);not null" json:"title" db:"title"`
+	Content string `gorm:"type:text" json:"content" db:"content"`
+	// Category は自由入力のカテゴリ。廃止予定で、CategoryID を使う
+	Category string `gorm:"type:varchar(100)" json:"category" db:"category"`
+	// CategoryID は分類するカテゴリの ID。NULL なら未分類
+	CategoryID *string        `gorm:"type:varchar(255);index" json:"category_id" db:"category_id"`
+	Tags       pq.StringArray `gorm:"type:text[];index:idx_notes_tags,type:gin" json:"tags" db:"tags"`
+	CreatedAt  time.Time      `gorm:"autoCreateTime" json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time      `gorm:"autoUpdateTime" json:"updated_at" db:"updated_at"`
+	// Version は更新のたびに1増える。楽観的排他制御に使う
+	Version int64 `gorm:"not null;default:1" json:"version" db:"version"`
+	// DeletedAt はゴミ箱に移動した日時。NULL でないノートは通常のクエリから除外される
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at" db:"deleted_at"`
+	// CategoryRef はカテゴリを削除したときに未分類に戻すための外部キー
+	CategoryRef *Category `gorm:"foreignKey:CategoryID;constraint:OnDelete:SET NULL" json:"-" db:"-"`
 }
 
 // BeforeCreate はノート作成前にUUIDを自動生成する
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// RevisionAction はリビジョンを記録した操作
+type RevisionAction string
+
+const (
+	RevisionCreate RevisionAction = "create"
+	RevisionUpdate RevisionAction = "update"
+	RevisionDelete RevisionAction = "delete"
+	// RevisionRestore は過去のリビジョンの内容に戻した操作
+	RevisionRestore RevisionAction = "restore"
+)
+
+// NoteRevision は操作した時点のノートのスナップショット。作成後は変更しない
+type NoteRevision struct {
+	ID     string `gorm:"primaryKey;type:varchar(255)" json:"id" db:"id"`
+	NoteID string `gorm:"type:varchar(255);not null;index" json:"note_id" db:"note_id"`
+	// Version は操作後のノートのバージョン
+	Version int64          `gorm:"not null" json:"version" db:"version"`
+	Action  RevisionAction `gorm:"type:varchar(20);not null" json:"action" db:"action"`
+	// EditorID は操作したユーザーの ID。認証が無効な場合は空文字
+	EditorID string `gorm:"type:varchar(255);not null;default:''" json:"editor_id" db:"editor_id"`
+	Title    string `gorm:"type:varchar(255);not null" json:"title" db:"title"`
+	Content  string `gorm:"type:text" json:"content" db:"content"`
+	Category string `gorm:"type:varchar(100)" json:"category" db:"category"`
+	// CategoryID は記録した時点のカテゴリ。カテゴリを削除しても残る
+	CategoryID *string        `gorm:"type:varchar(255)" json:"category_id" db:"category_id"`
+	Tags       pq.StringArray `gorm:"type:text[]" json:"tags" db:"tags"`
+	CreatedAt  time.Time      `gorm:"autoCreateTime" json:"created_at" db:"created_at"`
+	// Note はノートを完全に削除したときに履歴も消すための外部キー
+	Note *Note `gorm:"foreignKey:NoteID;constraint:OnDelete:CASCADE" json:"-" db:"-"`
+}
+
+// NewNoteRevision はノートの現在の状態からリビジョンを作る
+func NewNoteRevision(note *Note, action RevisionAction, editorID string) *NoteRevision {
+	return &NoteRevision{
+		NoteID:     note.ID,
+		Version:    note.Version,
+		Action:     action,
+		EditorID:   editorID,
+		Title:      note.Title,
+		Content:    note.Content,
+		Category:   note.Category,
+		CategoryID: note.CategoryID,
+		Tags:       note.Tags,
+	}
+}
+
+// BeforeCreate はリビジョン作成前にUUIDを自動生成する
+func (r *NoteRevision) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == "" {
+		r.ID = uuid.New().String()
+	}
+	return nil
+}
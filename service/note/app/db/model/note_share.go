@@ -0,0 +1,41 @@
+package model
+
+import "time"
+
+// ShareRole はノートを共有されたユーザーの権限
+type ShareRole string
+
+const (
+	// RoleOwner はノートの所有者。共有の権限としては保存しない
+	RoleOwner ShareRole = "owner"
+	// RoleViewer はノートを読める
+	RoleViewer ShareRole = "viewer"
+	// RoleCommenter はノートを読み、コメントできる
+	RoleCommenter ShareRole = "commenter"
+	// RoleEditor はノートを読み、更新できる
+	RoleEditor ShareRole = "editor"
+)
+
+// roleRanks は権限の強さ。大きいほど多くの操作ができる
+var roleRanks = map[ShareRole]int{
+	RoleViewer:    1,
+	RoleCommenter: 2,
+	RoleEditor:    3,
+	RoleOwner:     4,
+}
+
+// Allows は r が required 以上の権限かどうかを返す
+func (r ShareRole) Allows(required ShareRole) bool {
+	return roleRanks[r] >= roleRanks[required]
+}
+
+// NoteShare はノートを他のユーザーに共有した記録
+type NoteShare struct {
+	NoteID    string    `gorm:"primaryKey;type:varchar(255)" json:"note_id" db:"note_id"`
+	UserID    string    `gorm:"primaryKey;type:varchar(255);index" json:"user_id" db:"user_id"`
+	Role      ShareRole `gorm:"type:varchar(20);not null" json:"role" db:"role"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at" db:"updated_at"`
+	// Note はノートを完全に削除したときに共有も消すための外部キー
+	Note *Note `gorm:"foreignKey:NoteID;constraint:OnDelete:CASCADE" json:"-" db:"-"`
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"note/db/model"
 
+	"github.com/lib/pq"
 	"gorm.io/gorm"
 )
 
@@ -17,33 +18,82 @@ func (d *db) CreateNote(tx *gorm.DB, note *model.Note) error {
 	return nil
 }
 
-func (d *db) UpdateNote(tx *gorm.DB, note *model.Note) error {
+// UpdateNoteFields は指定したカラムだけを更新し、バージョンを1増やす。
+// expectedVersion が 0 より大きい場合は、そのバージョンのときだけ更新する。
+// updated_at は自動で更新される
+func (d *db) UpdateNoteFields(tx *gorm.DB, ownerID, id string, fields map[string]any, expectedVersion int64) error {
 	client := d.getClient(tx)
 
-	if err := client.Save(note).Error; err != nil {
-		return fmt.Errorf("failed to update note: %w", err)
+	values := make(map[string]any, len(fields)+1)
+	for column, value := range fields {
+		values[column] = value
+	}
+	values["version"] = gorm.Expr("version + 1")
+
+	query := client.Model(&model.Note{}).Where("id = ? AND owner_id = ?", id, ownerID)
+	if expectedVersion > 0 {
+		query = query.Where("version = ?", expectedVersion)
+	}
+
+	result := query.Updates(values)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update note with ID %s: %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return d.noRowsError(client, ownerID, id, expectedVersion)
 	}
 
 	return nil
 }
 
-func (d *db) DeleteNote(tx *gorm.DB, id string) error {
+// DeleteNote はノートをゴミ箱に移動する（論理削除）。
+// expectedVersion が 0 より大きい場合は、そのバージョンのときだけ削除する
+func (d *db) DeleteNote(tx *gorm.DB, ownerID, id string, expectedVersion int64) error {
 	client := d.getClient(tx)
 
-	if err := client.Delete(&model.Note{}, id).Error; err != nil {
-		return fmt.Errorf("failed to delete note with ID %s: %w", id, err)
+	// 文字列の主キーは Delete の引数に渡しても条件にならないので Where で指定する
+	query := client.Where("id = ? AND owner_id = ?", id, ownerID)
+	if expectedVersion > 0 {
+		query = query.Where("version = ?", expectedVersion)
+	}
+
+	result := query.Delete(&model.Note{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete note with ID %s: %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return d.noRowsError(client, ownerID, id, expectedVersion)
 	}
 
 	return nil
 }
 
-func (d *db) GetNoteByID(tx *gorm.DB, id string) (*model.Note, error) {
+// noRowsError は条件付きの更新・削除が1行も対象にしなかった理由を調べ、
+// ノートがなければ ErrNotFound、バージョンが違えば ErrVersionMismatch を返す
+func (d *db) noRowsError(client *gorm.DB, ownerID, id string, expectedVersion int64) error {
+	if expectedVersion <= 0 {
+		return fmt.Errorf("note with ID %s: %w", id, ErrNotFound)
+	}
+
+	var note model.Note
+	err := client.Session(&gorm.Session{NewDB: true}).Select("version").First(&note, "id = ? AND owner_id = ?", id, ownerID).Error
+	if err == gorm.ErrRecordNotFound {
+		return fmt.Errorf("note with ID %s: %w", id, ErrNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get version of note with ID %s: %w", id, err)
+	}
+
+	return fmt.Errorf("note with ID %s is at version %d, expected %d: %w", id, note.Version, expectedVersion, ErrVersionMismatch)
+}
+
+func (d *db) GetNoteByID(tx *gorm.DB, ownerID, id string) (*model.Note, error) {
 	client := d.getClient(tx)
 
 	var note model.Note
-	if err := client.First(&note, "id = ?", id).Error; err != nil {
+	if err := client.First(&note, "id = ? AND owner_id = ?", id, ownerID).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("note with ID %s not found", id)
+			return nil, fmt.Errorf("note with ID %s: %w", id, ErrNotFound)
 		}
 		return nil, fmt.Errorf("failed to get note by ID %s: %w", id, err)
 	}
@@ -51,33 +101,116 @@ func (d *db) GetNoteByID(tx *gorm.DB, id string) (*model.Note, error) {
 	return &note, nil
 }
 
-func (d *db) ListNotes(tx *gorm.DB, page, limit int32, category string, tags []string) ([]*model.Note, int64, error) {
+// ListNotesParams はノート一覧の取得条件
+type ListNotesParams struct {
+	OwnerID string
+	// IncludeShared は OwnerID のユーザーに共有されたノートも含める
+	IncludeShared bool
+	// Offset は After が nil のときに読み飛ばす件数
+	Offset   int32
+	Limit    int32
+	Category string
+	// CategoryID はカテゴリとその子孫のカテゴリのノートに絞り込む
+	CategoryID string
+	Tags       TagFilter
+	Order      NoteOrder
+	// After は前のページの最後のノート。指定するとその続きをキーセットで取得する
+	After *model.Note
+}
+
+// TagFilter はタグによる絞り込み。空のリストの条件は使わない
+type TagFilter struct {
+	// All のタグをすべて持つ
+	All []string
+	// Any のタグをいずれか持つ
+	Any []string
+	// None のタグをどれも持たない
+	None []string
+}
+
+// NoteOrder はノート一覧の並び順
+type NoteOrder struct {
+	// Field は created_at, updated_at, title のいずれか
+	Field string
+	Desc  bool
+}
+
+// DefaultNoteOrder は作成日時の新しい順
+var DefaultNoteOrder = NoteOrder{Field: "created_at", Desc: true}
+
+// noteOrderFields は並び替えに使えるカラム
+var noteOrderFields = map[string]bool{
+	"created_at": true,
+	"updated_at": true,
+	"title":      true,
+}
+
+// ValidNoteOrderField は並び替えに使えるカラムかどうかを返す
+func ValidNoteOrderField(field string) bool {
+	return noteOrderFields[field]
+}
+
+// orderValue はノートの並び替えカラムの値を返す
+func (o NoteOrder) orderValue(note *model.Note) any {
+	switch o.Field {
+	case "updated_at":
+		return note.UpdatedAt
+	case "title":
+		return note.Title
+	default:
+		return note.CreatedAt
+	}
+}
+
+func (d *db) ListNotes(tx *gorm.DB, params ListNotesParams) ([]*model.Note, int64, error) {
 	client := d.getClient(tx)
 
 	var notes []*model.Note
 	var totalCount int64
 
 	// デフォルト値の設定
-	if page <= 0 {
-		page = 1
-	}
+	limit, order := params.Limit, params.Order
 	if limit <= 0 {
 		limit = 10
 	}
-
-	offset := (page - 1) * limit
+	if order.Field == "" {
+		order = DefaultNoteOrder
+	}
+	if !ValidNoteOrderField(order.Field) {
+		return nil, 0, fmt.Errorf("invalid order field: %s", order.Field)
+	}
 
 	// クエリビルダーを作成
 	query := client.Model(&model.Note{})
 
+	// 所有者フィルタ。include_shared なら共有されたノートも含める
+	if params.IncludeShared {
+		query = query.Where(
+			"(owner_id = ? OR id IN (SELECT note_id FROM note_shares WHERE user_id = ?))",
+			params.OwnerID, params.OwnerID,
+		)
+	} else {
+		query = query.Where("owner_id = ?", params.OwnerID)
+	}
+
 	// カテゴリフィルタ
-	if category != "" {
-		query = query.Where("category = ?", category)
+	if params.Category != "" {
+		query = query.Where("category = ?", params.Category)
+	}
+	if params.CategoryID != "" {
+		query = query.Where("category_id IN ("+descendantCategoriesSQL+")", params.CategoryID)
 	}
 
-	// タグフィルタ
-	if len(tags) > 0 {
-		query = query.Where("tags @> ?", tags)
+	// タグフィルタ。@> と && は tags の GIN インデックスを使える
+	if len(params.Tags.All) > 0 {
+		query = query.Where("tags @> ?", pq.StringArray(params.Tags.All))
+	}
+	if len(params.Tags.Any) > 0 {
+		query = query.Where("tags && ?", pq.StringArray(params.Tags.Any))
+	}
+	if len(params.Tags.None) > 0 {
+		// タグのないノートも含めるため NULL を空の配列として比較する
+		query = query.Where("NOT (COALESCE(tags, '{}') && ?)", pq.StringArray(params.Tags.None))
 	}
 
 	// 総件数を取得
@@ -85,8 +218,23 @@ func (d *db) ListNotes(tx *gorm.DB, page, limit int32, category string, tags []s
 		return nil, 0, fmt.Errorf("failed to count notes: %w", err)
 	}
 
-	// ページネーション付きでノートを取得
-	if err := query.Offset(int(offset)).Limit(int(limit)).Order("created_at DESC").Find(&notes).Error; err != nil {
+	// 同じ値のノートがあっても順序が決まるよう ID を第2キーにする
+	direction := "ASC"
+	comparison := ">"
+	if order.Desc {
+		direction = "DESC"
+		comparison = "<"
+	}
+	query = query.Order(fmt.Sprintf("%s %s, id %s", order.Field, direction, direction))
+
+	if params.After != nil {
+		// キーセット: 前のページの最後のノートより後ろから取得する
+		query = query.Where(fmt.Sprintf("(%s, id) %s (?, ?)", order.Field, comparison), order.orderValue(params.After), params.After.ID)
+	} else {
+		query = query.Offset(int(params.Offset))
+	}
+
+	if err := query.Limit(int(limit)).Find(&notes).Error; err != nil {
 		return nil, 0, fmt.Errorf("failed to list notes: %w", err)
 	}
 
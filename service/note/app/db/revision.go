@@ -0,0 +1,66 @@
+package db
+
+import (
+	"fmt"
+	"note/db/model"
+
+	"gorm.io/gorm"
+)
+
+// CreateNoteRevision はノートのリビジョンを記録する
+func (d *db) CreateNoteRevision(tx *gorm.DB, revision *model.NoteRevision) error {
+	client := d.getClient(tx)
+
+	if err := client.Create(revision).Error; err != nil {
+		return fmt.Errorf("failed to create revision of note %s: %w", revision.NoteID, err)
+	}
+
+	return nil
+}
+
+// ListNoteRevisions はノートのリビジョンを新しい順に返す
+func (d *db) ListNoteRevisions(tx *gorm.DB, noteID string, page, limit int32) ([]*model.NoteRevision, int64, error) {
+	client := d.getClient(tx)
+
+	var revisions []*model.NoteRevision
+	var totalCount int64
+
+	// デフォルト値の設定
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+
+	offset := (page - 1) * limit
+
+	query := client.Model(&model.NoteRevision{}).Where("note_id = ?", noteID)
+
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count revisions of note %s: %w", noteID, err)
+	}
+
+	// 同じ時刻に記録したリビジョンはバージョンの大きい方を新しいとみなす
+	err := query.Order("created_at DESC, version DESC").Offset(int(offset)).Limit(int(limit)).Find(&revisions).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list revisions of note %s: %w", noteID, err)
+	}
+
+	return revisions, totalCount, nil
+}
+
+// GetNoteRevision はノートのリビジョンを返す。他のノートのリビジョンは ErrNotFound になる
+func (d *db) GetNoteRevision(tx *gorm.DB, noteID, id string) (*model.NoteRevision, error) {
+	client := d.getClient(tx)
+
+	var revision model.NoteRevision
+	if err := client.First(&revision, "id = ? AND note_id = ?", id, noteID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("revision %s of note %s: %w", id, noteID, ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to get revision %s of note %s: %w", id, noteID, err)
+	}
+
+	return &revision, nil
+}
@@ -0,0 +1,95 @@
+package db
+
+import (
+	"fmt"
+	"note/db/model"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// searchConfig は全文検索で使うテキスト検索設定。
+// 日本語のパーサーは標準で用意されていないため、言語に依存しない simple を使う
+const searchConfig = "simple"
+
+// headlineOptions は ts_headline のスニペットの設定
+const headlineOptions = "MaxFragments=2, MaxWords=20, MinWords=5, StartSel=<b>, StopSel=</b>"
+
+// SearchResult は全文検索でヒットしたノートとその関連度・スニペット
+type SearchResult struct {
+	model.Note
+	Rank     float32
+	Headline string
+}
+
+// migrateSearch はタイトルと本文から作る tsvector の生成列と GIN インデックスを作成する。
+// タイトルの一致を本文より重く評価する
+func migrateSearch(db *gorm.DB) error {
+	statements := []string{
+		fmt.Sprintf(`ALTER TABLE notes ADD COLUMN IF NOT EXISTS search_vector tsvector
+			GENERATED ALWAYS AS (
+				setweight(to_tsvector('%[1]s', coalesce(title, '')), 'A') ||
+				setweight(to_tsvector('%[1]s', coalesce(content, '')), 'B')
+			) STORED`, searchConfig),
+		`CREATE INDEX IF NOT EXISTS idx_notes_search_vector ON notes USING GIN (search_vector)`,
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			return fmt.Errorf("failed to migrate search column: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// SearchNotes は websearch 形式のクエリでノートを全文検索し、関連度の高い順に返す
+func (d *db) SearchNotes(tx *gorm.DB, ownerID, query string, page, limit int32, category, categoryID string, tags []string) ([]*SearchResult, int64, error) {
+	client := d.getClient(tx)
+
+	var results []*SearchResult
+	var totalCount int64
+
+	// デフォルト値の設定
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+
+	offset := (page - 1) * limit
+
+	// クエリを一度だけ解析し、絞り込み・順位付け・スニペットで共有する
+	search := client.Model(&model.Note{}).
+		Joins("CROSS JOIN websearch_to_tsquery(?, ?) AS query", searchConfig, query).
+		Where("notes.owner_id = ?", ownerID).
+		Where("notes.search_vector @@ query")
+
+	// カテゴリフィルタ
+	if category != "" {
+		search = search.Where("notes.category = ?", category)
+	}
+	if categoryID != "" {
+		search = search.Where("notes.category_id IN ("+descendantCategoriesSQL+")", categoryID)
+	}
+
+	// タグフィルタ
+	if len(tags) > 0 {
+		search = search.Where("notes.tags @> ?", pq.StringArray(tags))
+	}
+
+	if err := search.Count(&totalCount).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count search results: %w", err)
+	}
+
+	err := search.
+		Select("notes.*, ts_rank(notes.search_vector, query) AS rank, ts_headline(?, notes.content, query, ?) AS headline", searchConfig, headlineOptions).
+		Order("rank DESC, notes.updated_at DESC").
+		Offset(int(offset)).Limit(int(limit)).
+		Scan(&results).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search notes: %w", err)
+	}
+
+	return results, totalCount, nil
+}
@@ -0,0 +1,122 @@
+package db
+
+import (
+	"fmt"
+	"note/db/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SharedNote は共有されたノートとその権限
+type SharedNote struct {
+	model.Note
+	Role model.ShareRole
+}
+
+// GetAccessibleNote は userID が所有しているか共有されているノートを、その権限とともに返す。
+// どちらでもなければ ErrNotFound を返す
+func (d *db) GetAccessibleNote(tx *gorm.DB, userID, id string) (*model.Note, model.ShareRole, error) {
+	client := d.getClient(tx)
+
+	var note model.Note
+	if err := client.First(&note, "id = ?", id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, "", fmt.Errorf("note with ID %s: %w", id, ErrNotFound)
+		}
+		return nil, "", fmt.Errorf("failed to get note by ID %s: %w", id, err)
+	}
+	if note.OwnerID == userID {
+		return &note, model.RoleOwner, nil
+	}
+
+	var share model.NoteShare
+	if err := client.First(&share, "note_id = ? AND user_id = ?", id, userID).Error; err != nil {
+		// 他のユーザーのノートは存在しないノートと区別しない
+		if err == gorm.ErrRecordNotFound {
+			return nil, "", fmt.Errorf("note with ID %s: %w", id, ErrNotFound)
+		}
+		return nil, "", fmt.Errorf("failed to get share of note %s: %w", id, err)
+	}
+
+	return &note, share.Role, nil
+}
+
+// ShareNote はノートを共有する。すでに共有している場合は権限を更新する
+func (d *db) ShareNote(tx *gorm.DB, share *model.NoteShare) error {
+	client := d.getClient(tx)
+
+	err := client.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "note_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role", "updated_at"}),
+	}).Create(share).Error
+	if err != nil {
+		return fmt.Errorf("failed to share note %s with %s: %w", share.NoteID, share.UserID, err)
+	}
+
+	return nil
+}
+
+// UnshareNote はノートの共有を解除する
+func (d *db) UnshareNote(tx *gorm.DB, noteID, userID string) error {
+	client := d.getClient(tx)
+
+	result := client.Where("note_id = ? AND user_id = ?", noteID, userID).Delete(&model.NoteShare{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to unshare note %s with %s: %w", noteID, userID, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("share of note %s with %s: %w", noteID, userID, ErrNotFound)
+	}
+
+	return nil
+}
+
+// ListNoteShares はノートの共有先を共有した順に返す
+func (d *db) ListNoteShares(tx *gorm.DB, noteID string) ([]*model.NoteShare, error) {
+	client := d.getClient(tx)
+
+	var shares []*model.NoteShare
+	if err := client.Where("note_id = ?", noteID).Order("created_at ASC").Find(&shares).Error; err != nil {
+		return nil, fmt.Errorf("failed to list shares of note %s: %w", noteID, err)
+	}
+
+	return shares, nil
+}
+
+// ListSharedWithMe は userID に共有されたノートを更新日時の新しい順に返す
+func (d *db) ListSharedWithMe(tx *gorm.DB, userID string, page, limit int32) ([]*SharedNote, int64, error) {
+	client := d.getClient(tx)
+
+	var notes []*SharedNote
+	var totalCount int64
+
+	// デフォルト値の設定
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+
+	offset := (page - 1) * limit
+
+	query := client.Model(&model.Note{}).
+		Joins("JOIN note_shares ON note_shares.note_id = notes.id").
+		Where("note_shares.user_id = ?", userID)
+
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count shared notes: %w", err)
+	}
+
+	err := query.
+		Select("notes.*, note_shares.role AS role").
+		Order("notes.updated_at DESC, notes.id DESC").
+		Offset(int(offset)).Limit(int(limit)).
+		Scan(&notes).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list shared notes: %w", err)
+	}
+
+	return notes, totalCount, nil
+}
@@ -0,0 +1,105 @@
+package db
+
+import (
+	"fmt"
+	"note/db/model"
+	"note/tag"
+	"strings"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TagCount はタグとそのタグが付いたノートの数
+type TagCount struct {
+	Name      string
+	NoteCount int64
+}
+
+// migrateTags は正規化の導入前に保存されたタグを、前後の空白を除いた小文字にそろえる。
+// tag.MaxLength より長いタグはその文字数に切り詰める。重複したタグは最初の位置に残す
+func migrateTags(db *gorm.DB) error {
+	normalizeSQL := fmt.Sprintf("btrim(left(lower(btrim(u.tag)), %d))", tag.MaxLength)
+	err := db.Exec(`UPDATE notes SET tags = COALESCE((
+			SELECT array_agg(t ORDER BY o) FROM (
+				SELECT ` + normalizeSQL + ` AS t, min(u.ord) AS o
+				FROM unnest(notes.tags) WITH ORDINALITY AS u(tag, ord)
+				GROUP BY 1
+			) AS normalized WHERE t <> ''
+		), '{}')
+		WHERE EXISTS (
+			SELECT 1 FROM unnest(notes.tags) AS u(tag)
+			WHERE u.tag <> ` + normalizeSQL + ` OR ` + normalizeSQL + ` = ''
+		)`).Error
+	if err != nil {
+		return fmt.Errorf("failed to migrate tags: %w", err)
+	}
+
+	return nil
+}
+
+// ListTags はユーザーのノートのタグを、ノートの数が多い順に返す。
+// prefix を指定するとその文字列で始まるタグだけを返す。ゴミ箱のノートは数えない
+func (d *db) ListTags(tx *gorm.DB, ownerID, prefix string, limit int32) ([]*TagCount, error) {
+	client := d.getClient(tx)
+
+	var tags []*TagCount
+
+	query := client.Model(&model.Note{}).
+		Joins("CROSS JOIN unnest(notes.tags) AS tag").
+		Where("notes.owner_id = ?", ownerID)
+	if prefix != "" {
+		query = query.Where(`tag LIKE ? ESCAPE '\'`, escapeLike(prefix)+"%")
+	}
+
+	err := query.
+		Select("tag AS name, count(*) AS note_count").
+		Group("tag").
+		Order("note_count DESC, tag ASC").
+		Limit(int(limit)).
+		Scan(&tags).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+// escapeLike は LIKE のワイルドカードをエスケープする
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// FindNotesByTags はいずれかのタグが付いたユーザーのノートを、更新用にロックして返す。
+// ゴミ箱のノートも含む
+func (d *db) FindNotesByTags(tx *gorm.DB, ownerID string, tags []string) ([]*model.Note, error) {
+	client := d.getClient(tx)
+
+	var notes []*model.Note
+	err := client.Unscoped().
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("owner_id = ? AND tags && ?", ownerID, pq.StringArray(tags)).
+		Order("id ASC").
+		Find(&notes).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find notes by tags: %w", err)
+	}
+
+	return notes, nil
+}
+
+// SetNoteTags はノートのタグを置き換え、バージョンを1増やす。ゴミ箱のノートも対象
+func (d *db) SetNoteTags(tx *gorm.DB, id string, tags []string) error {
+	client := d.getClient(tx)
+
+	err := client.Unscoped().Model(&model.Note{}).Where("id = ?", id).Updates(map[string]any{
+		"tags":    pq.StringArray(tags),
+		"version": gorm.Expr("version + 1"),
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to set tags of note %s: %w", id, err)
+	}
+
+	return nil
+}
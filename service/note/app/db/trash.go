@@ -0,0 +1,84 @@
+package db
+
+import (
+	"fmt"
+	"note/db/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ListDeletedNotes はゴミ箱のノートを削除日時の新しい順に取得する
+func (d *db) ListDeletedNotes(tx *gorm.DB, ownerID string, page, limit int32) ([]*model.Note, int64, error) {
+	client := d.getClient(tx)
+
+	var notes []*model.Note
+	var totalCount int64
+
+	// デフォルト値の設定
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+
+	offset := (page - 1) * limit
+
+	// Unscoped で論理削除されたノートも対象にする
+	query := client.Unscoped().Model(&model.Note{}).Where("owner_id = ? AND deleted_at IS NOT NULL", ownerID)
+
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count deleted notes: %w", err)
+	}
+
+	if err := query.Offset(int(offset)).Limit(int(limit)).Order("deleted_at DESC").Find(&notes).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list deleted notes: %w", err)
+	}
+
+	return notes, totalCount, nil
+}
+
+// RestoreNote はゴミ箱のノートを元に戻す
+func (d *db) RestoreNote(tx *gorm.DB, ownerID, id string) error {
+	client := d.getClient(tx)
+
+	result := client.Unscoped().Model(&model.Note{}).
+		Where("id = ? AND owner_id = ? AND deleted_at IS NOT NULL", id, ownerID).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		return fmt.Errorf("failed to restore note with ID %s: %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("deleted note with ID %s: %w", id, ErrNotFound)
+	}
+
+	return nil
+}
+
+// PurgeNote はゴミ箱のノートを完全に削除する
+func (d *db) PurgeNote(tx *gorm.DB, ownerID, id string) error {
+	client := d.getClient(tx)
+
+	result := client.Unscoped().Where("id = ? AND owner_id = ? AND deleted_at IS NOT NULL", id, ownerID).Delete(&model.Note{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to purge note with ID %s: %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("deleted note with ID %s: %w", id, ErrNotFound)
+	}
+
+	return nil
+}
+
+// PurgeDeletedNotes は before より前にゴミ箱に移動したノートを完全に削除し、削除した件数を返す
+func (d *db) PurgeDeletedNotes(tx *gorm.DB, before time.Time) (int64, error) {
+	client := d.getClient(tx)
+
+	result := client.Unscoped().Where("deleted_at IS NOT NULL AND deleted_at < ?", before).Delete(&model.Note{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to purge deleted notes: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
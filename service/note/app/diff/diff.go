@@ -0,0 +1,110 @@
+// Package diff はテキストの行単位の差分を計算する
+package diff
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MaxLines は共通の先頭と末尾の行を除いて比較できる、それぞれのテキストの行数の上限。
+// LCS の表は行数の積の大きさになるため、大きすぎる差分は計算しない
+const MaxLines = 2000
+
+// ErrTooLarge は比較する行数が MaxLines を超える場合に返される
+var ErrTooLarge = errors.New("texts are too large to diff")
+
+// Op は差分の1行に対する操作
+type Op int
+
+const (
+	// Equal は両方にある行
+	Equal Op = iota
+	// Insert は新しいテキストにだけある行
+	Insert
+	// Delete は古いテキストにだけある行
+	Delete
+)
+
+// Line は差分の1行
+type Line struct {
+	Op   Op
+	Text string
+}
+
+// Lines は a から b への行単位の差分を返す。
+// 最長共通部分列 (LCS) で共通の行を求め、同じ位置では削除を挿入より先に並べる。
+// 共通の先頭と末尾を除いた行数が MaxLines を超える場合は ErrTooLarge を返す
+func Lines(a, b string) ([]Line, error) {
+	x, y := splitLines(a), splitLines(b)
+
+	// 共通の先頭と末尾の行は LCS の表に含めない
+	prefix := 0
+	for prefix < len(x) && prefix < len(y) && x[prefix] == y[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(x)-prefix && suffix < len(y)-prefix && x[len(x)-1-suffix] == y[len(y)-1-suffix] {
+		suffix++
+	}
+	common := x[len(x)-suffix:]
+	lines := make([]Line, 0, len(x)+len(y))
+	for _, text := range x[:prefix] {
+		lines = append(lines, Line{Op: Equal, Text: text})
+	}
+	x, y = x[prefix:len(x)-suffix], y[prefix:len(y)-suffix]
+
+	if len(x) > MaxLines || len(y) > MaxLines {
+		return nil, fmt.Errorf("%w: %d and %d changed lines, the limit is %d", ErrTooLarge, len(x), len(y), MaxLines)
+	}
+
+	// lcs[i][j] は x[i:] と y[j:] の最長共通部分列の長さ
+	lcs := make([][]int, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(x) && j < len(y) {
+		switch {
+		case x[i] == y[j]:
+			lines = append(lines, Line{Op: Equal, Text: x[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, Line{Op: Delete, Text: x[i]})
+			i++
+		default:
+			lines = append(lines, Line{Op: Insert, Text: y[j]})
+			j++
+		}
+	}
+	for ; i < len(x); i++ {
+		lines = append(lines, Line{Op: Delete, Text: x[i]})
+	}
+	for ; j < len(y); j++ {
+		lines = append(lines, Line{Op: Insert, Text: y[j]})
+	}
+	for _, text := range common {
+		lines = append(lines, Line{Op: Equal, Text: text})
+	}
+
+	return lines, nil
+}
+
+// splitLines はテキストを行に分ける。空のテキストは0行で、末尾の改行は行を増やさない
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
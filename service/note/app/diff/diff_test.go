@@ -0,0 +1,64 @@
+package diff
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want []Line
+	}{
+		{"both empty", "", "", []Line{}},
+		{"same", "a\nb\n", "a\nb", []Line{{Equal, "a"}, {Equal, "b"}}},
+		{"added", "", "a\nb", []Line{{Insert, "a"}, {Insert, "b"}}},
+		{"removed", "a\nb", "", []Line{{Delete, "a"}, {Delete, "b"}}},
+		{
+			"changed line",
+			"title\nold\nend",
+			"title\nnew\nend",
+			[]Line{{Equal, "title"}, {Delete, "old"}, {Insert, "new"}, {Equal, "end"}},
+		},
+		{
+			"moved line",
+			"a\nb\nc",
+			"b\nc\na",
+			[]Line{{Delete, "a"}, {Equal, "b"}, {Equal, "c"}, {Insert, "a"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Lines(tt.a, tt.b)
+			if err != nil {
+				t.Fatalf("Lines failed: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLinesTooLarge(t *testing.T) {
+	var a, b strings.Builder
+	for i := 0; i <= MaxLines; i++ {
+		fmt.Fprintf(&a, "old %d\n", i)
+		fmt.Fprintf(&b, "new %d\n", i)
+	}
+
+	if _, err := Lines(a.String(), b.String()); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("Expected ErrTooLarge, got %v", err)
+	}
+
+	// 共通の先頭と末尾は上限に数えない
+	same := "title\n" + a.String() + "end\n"
+	if _, err := Lines(same, "title\nchanged\n"+a.String()+"end\n"); err != nil {
+		t.Errorf("Expected a small change in a large text to be diffed, got %v", err)
+	}
+}
@@ -9,6 +9,7 @@ package grpc
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,15 +23,189 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ShareRole は共有されたユーザーの権限
+type ShareRole int32
+
+const (
+	ShareRole_SHARE_ROLE_UNSPECIFIED ShareRole = 0
+	// 読み取りのみ
+	ShareRole_SHARE_ROLE_VIEWER ShareRole = 1
+	// 読み取りとコメント
+	ShareRole_SHARE_ROLE_COMMENTER ShareRole = 2
+	// 読み取りと更新。削除は所有者だけができる
+	ShareRole_SHARE_ROLE_EDITOR ShareRole = 3
+)
+
+// Enum value maps for ShareRole.
+var (
+	ShareRole_name = map[int32]string{
+		0: "SHARE_ROLE_UNSPECIFIED",
+		1: "SHARE_ROLE_VIEWER",
+		2: "SHARE_ROLE_COMMENTER",
+		3: "SHARE_ROLE_EDITOR",
+	}
+	ShareRole_value = map[string]int32{
+		"SHARE_ROLE_UNSPECIFIED": 0,
+		"SHARE_ROLE_VIEWER":      1,
+		"SHARE_ROLE_COMMENTER":   2,
+		"SHARE_ROLE_EDITOR":      3,
+	}
+)
+
+func (x ShareRole) Enum() *ShareRole {
+	p := new(ShareRole)
+	*p = x
+	return p
+}
+
+func (x ShareRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShareRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_api_note_proto_enumTypes[0].Descriptor()
+}
+
+func (ShareRole) Type() protoreflect.EnumType {
+	return &file_proto_api_note_proto_enumTypes[0]
+}
+
+func (x ShareRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShareRole.Descriptor instead.
+func (ShareRole) EnumDescriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{0}
+}
+
+// RevisionAction はリビジョンを記録した操作
+type RevisionAction int32
+
+const (
+	RevisionAction_REVISION_ACTION_UNSPECIFIED RevisionAction = 0
+	RevisionAction_REVISION_ACTION_CREATE      RevisionAction = 1
+	RevisionAction_REVISION_ACTION_UPDATE      RevisionAction = 2
+	RevisionAction_REVISION_ACTION_DELETE      RevisionAction = 3
+	// 過去のリビジョンの内容に戻した操作
+	RevisionAction_REVISION_ACTION_RESTORE RevisionAction = 4
+)
+
+// Enum value maps for RevisionAction.
+var (
+	RevisionAction_name = map[int32]string{
+		0: "REVISION_ACTION_UNSPECIFIED",
+		1: "REVISION_ACTION_CREATE",
+		2: "REVISION_ACTION_UPDATE",
+		3: "REVISION_ACTION_DELETE",
+		4: "REVISION_ACTION_RESTORE",
+	}
+	RevisionAction_value = map[string]int32{
+		"REVISION_ACTION_UNSPECIFIED": 0,
+		"REVISION_ACTION_CREATE":      1,
+		"REVISION_ACTION_UPDATE":      2,
+		"REVISION_ACTION_DELETE":      3,
+		"REVISION_ACTION_RESTORE":     4,
+	}
+)
+
+func (x RevisionAction) Enum() *RevisionAction {
+	p := new(RevisionAction)
+	*p = x
+	return p
+}
+
+func (x RevisionAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RevisionAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_api_note_proto_enumTypes[1].Descriptor()
+}
+
+func (RevisionAction) Type() protoreflect.EnumType {
+	return &file_proto_api_note_proto_enumTypes[1]
+}
+
+func (x RevisionAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RevisionAction.Descriptor instead.
+func (RevisionAction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{1}
+}
+
+type DiffOp int32
+
+const (
+	DiffOp_DIFF_OP_UNSPECIFIED DiffOp = 0
+	DiffOp_DIFF_OP_EQUAL       DiffOp = 1
+	DiffOp_DIFF_OP_INSERT      DiffOp = 2
+	DiffOp_DIFF_OP_DELETE      DiffOp = 3
+)
+
+// Enum value maps for DiffOp.
+var (
+	DiffOp_name = map[int32]string{
+		0: "DIFF_OP_UNSPECIFIED",
+		1: "DIFF_OP_EQUAL",
+		2: "DIFF_OP_INSERT",
+		3: "DIFF_OP_DELETE",
+	}
+	DiffOp_value = map[string]int32{
+		"DIFF_OP_UNSPECIFIED": 0,
+		"DIFF_OP_EQUAL":       1,
+		"DIFF_OP_INSERT":      2,
+		"DIFF_OP_DELETE":      3,
+	}
+)
+
+func (x DiffOp) Enum() *DiffOp {
+	p := new(DiffOp)
+	*p = x
+	return p
+}
+
+func (x DiffOp) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiffOp) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_api_note_proto_enumTypes[2].Descriptor()
+}
+
+func (DiffOp) Type() protoreflect.EnumType {
+	return &file_proto_api_note_proto_enumTypes[2]
+}
+
+func (x DiffOp) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiffOp.Descriptor instead.
+func (DiffOp) EnumDescriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{2}
+}
+
 type Note struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
-	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title   string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// category_id のカテゴリの名前。未分類なら空。廃止予定で、category_id を使う
+	Category  string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Tags      []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// ゴミ箱に移動した日時。ゴミ箱にないノートでは未設定
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// 更新のたびに1増えるバージョン。expected_version に指定して競合を検出する
+	Version int64 `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	// ノートを作成したユーザーの ID。認証が無効な場合は空
+	OwnerId string `protobuf:"bytes,10,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// 分類するカテゴリの ID。未分類なら空
+	CategoryId    string `protobuf:"bytes,11,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -114,12 +289,43 @@ func (x *Note) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Note) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Note) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Note) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Note) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
 type CreateNoteRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Title   string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Content string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// 廃止予定で、無視される。category_id を使う
+	Category string   `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Tags     []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// 分類するカテゴリの ID。空なら未分類
+	CategoryId    string `protobuf:"bytes,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -182,6 +388,13 @@ func (x *CreateNoteRequest) GetTags() []string {
 	return nil
 }
 
+func (x *CreateNoteRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
 type CreateNoteResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Note          *Note                  `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
@@ -315,11 +528,26 @@ func (x *GetNoteResponse) GetNote() *Note {
 }
 
 type ListNotesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page は page_token がないときのオフセット用のページ番号（互換性のため残している）
+	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	// limit は1ページの件数。上限は 100
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// 廃止予定。名前が一致するカテゴリのノートに絞り込む。category_id を使う
+	Category string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	// tags のタグをすべて持つノートに絞り込む。tag_filter.all と同じ
+	Tags []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// page_token は前のレスポンスの next_page_token。order_by とフィルタは同じ値を指定する
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// order_by は created_at, updated_at, title のいずれかに asc か desc を続ける。
+	// 方向の省略時は asc、order_by の省略時は "created_at desc"
+	OrderBy string `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// include_shared を true にすると、共有されたノートも含める
+	IncludeShared bool `protobuf:"varint,7,opt,name=include_shared,json=includeShared,proto3" json:"include_shared,omitempty"`
+	// category_id を指定すると、そのカテゴリと子孫のカテゴリのノートに絞り込む
+	CategoryId string `protobuf:"bytes,8,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// tag_filter はタグによる絞り込み。tags は tag_filter.all に加えて扱う
+	TagFilter     *TagFilter `protobuf:"bytes,9,opt,name=tag_filter,json=tagFilter,proto3" json:"tag_filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -382,17 +610,118 @@ func (x *ListNotesRequest) GetTags() []string {
 	return nil
 }
 
+func (x *ListNotesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListNotesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListNotesRequest) GetIncludeShared() bool {
+	if x != nil {
+		return x.IncludeShared
+	}
+	return false
+}
+
+func (x *ListNotesRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *ListNotesRequest) GetTagFilter() *TagFilter {
+	if x != nil {
+		return x.TagFilter
+	}
+	return nil
+}
+
+// TagFilter はタグによる絞り込み。指定したリストの条件をすべて満たすノートを返す
+type TagFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// all のタグをすべて持つ
+	All []string `protobuf:"bytes,1,rep,name=all,proto3" json:"all,omitempty"`
+	// any のタグをいずれか持つ
+	Any []string `protobuf:"bytes,2,rep,name=any,proto3" json:"any,omitempty"`
+	// none のタグをどれも持たない
+	None          []string `protobuf:"bytes,3,rep,name=none,proto3" json:"none,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagFilter) Reset() {
+	*x = TagFilter{}
+	mi := &file_proto_api_note_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagFilter) ProtoMessage() {}
+
+func (x *TagFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagFilter.ProtoReflect.Descriptor instead.
+func (*TagFilter) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TagFilter) GetAll() []string {
+	if x != nil {
+		return x.All
+	}
+	return nil
+}
+
+func (x *TagFilter) GetAny() []string {
+	if x != nil {
+		return x.Any
+	}
+	return nil
+}
+
+func (x *TagFilter) GetNone() []string {
+	if x != nil {
+		return x.None
+	}
+	return nil
+}
+
 type ListNotesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Notes         []*Note                `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Notes      []*Note                `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	TotalCount int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// next_page_token は次のページを取得するためのトークン。最後のページでは空
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListNotesResponse) Reset() {
 	*x = ListNotesResponse{}
-	mi := &file_proto_api_note_proto_msgTypes[6]
+	mi := &file_proto_api_note_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -404,7 +733,7 @@ func (x *ListNotesResponse) String() string {
 func (*ListNotesResponse) ProtoMessage() {}
 
 func (x *ListNotesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_note_proto_msgTypes[6]
+	mi := &file_proto_api_note_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,7 +746,7 @@ func (x *ListNotesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNotesResponse.ProtoReflect.Descriptor instead.
 func (*ListNotesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_note_proto_rawDescGZIP(), []int{6}
+	return file_proto_api_note_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListNotesResponse) GetNotes() []*Note {
@@ -434,32 +763,44 @@ func (x *ListNotesResponse) GetTotalCount() int32 {
 	return 0
 }
 
-type UpdateNoteRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
-	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+func (x *ListNotesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SearchNotesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// websearch 形式のクエリ。例: "gRPC ストリーム" -java "exact phrase" go or rust
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page  int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// limit は1ページの件数。上限は 100
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// 廃止予定。名前が一致するカテゴリのノートに絞り込む。category_id を使う
+	Category string   `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Tags     []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	// category_id を指定すると、そのカテゴリと子孫のカテゴリのノートに絞り込む
+	CategoryId    string `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateNoteRequest) Reset() {
-	*x = UpdateNoteRequest{}
-	mi := &file_proto_api_note_proto_msgTypes[7]
+func (x *SearchNotesRequest) Reset() {
+	*x = SearchNotesRequest{}
+	mi := &file_proto_api_note_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateNoteRequest) String() string {
+func (x *SearchNotesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateNoteRequest) ProtoMessage() {}
+func (*SearchNotesRequest) ProtoMessage() {}
 
-func (x *UpdateNoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_note_proto_msgTypes[7]
+func (x *SearchNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -470,68 +811,79 @@ func (x *UpdateNoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateNoteRequest.ProtoReflect.Descriptor instead.
-func (*UpdateNoteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_note_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use SearchNotesRequest.ProtoReflect.Descriptor instead.
+func (*SearchNotesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdateNoteRequest) GetId() string {
+func (x *SearchNotesRequest) GetQuery() string {
 	if x != nil {
-		return x.Id
+		return x.Query
 	}
 	return ""
 }
 
-func (x *UpdateNoteRequest) GetTitle() string {
+func (x *SearchNotesRequest) GetPage() int32 {
 	if x != nil {
-		return x.Title
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateNoteRequest) GetContent() string {
+func (x *SearchNotesRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Content
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateNoteRequest) GetCategory() string {
+func (x *SearchNotesRequest) GetCategory() string {
 	if x != nil {
 		return x.Category
 	}
 	return ""
 }
 
-func (x *UpdateNoteRequest) GetTags() []string {
+func (x *SearchNotesRequest) GetTags() []string {
 	if x != nil {
 		return x.Tags
 	}
 	return nil
 }
 
-type UpdateNoteResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Note          *Note                  `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+func (x *SearchNotesRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+type SearchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Note  *Note                  `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	// ts_rank による関連度。大きいほど関連が高い
+	Rank float32 `protobuf:"fixed32,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// 本文中の一致箇所のスニペット。一致した語は <b></b> で囲まれる
+	Headline      string `protobuf:"bytes,3,opt,name=headline,proto3" json:"headline,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateNoteResponse) Reset() {
-	*x = UpdateNoteResponse{}
-	mi := &file_proto_api_note_proto_msgTypes[8]
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_proto_api_note_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateNoteResponse) String() string {
+func (x *SearchResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateNoteResponse) ProtoMessage() {}
+func (*SearchResult) ProtoMessage() {}
 
-func (x *UpdateNoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_note_proto_msgTypes[8]
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -542,40 +894,55 @@ func (x *UpdateNoteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateNoteResponse.ProtoReflect.Descriptor instead.
-func (*UpdateNoteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_note_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UpdateNoteResponse) GetNote() *Note {
+func (x *SearchResult) GetNote() *Note {
 	if x != nil {
 		return x.Note
 	}
 	return nil
 }
 
-type DeleteNoteRequest struct {
+func (x *SearchResult) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *SearchResult) GetHeadline() string {
+	if x != nil {
+		return x.Headline
+	}
+	return ""
+}
+
+type SearchNotesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Results       []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteNoteRequest) Reset() {
-	*x = DeleteNoteRequest{}
-	mi := &file_proto_api_note_proto_msgTypes[9]
+func (x *SearchNotesResponse) Reset() {
+	*x = SearchNotesResponse{}
+	mi := &file_proto_api_note_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteNoteRequest) String() string {
+func (x *SearchNotesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteNoteRequest) ProtoMessage() {}
+func (*SearchNotesResponse) ProtoMessage() {}
 
-func (x *DeleteNoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_note_proto_msgTypes[9]
+func (x *SearchNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,40 +953,2402 @@ func (x *DeleteNoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteNoteRequest.ProtoReflect.Descriptor instead.
-func (*DeleteNoteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_note_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use SearchNotesResponse.ProtoReflect.Descriptor instead.
+func (*SearchNotesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SearchNotesResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchNotesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type UpdateNoteRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title   string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// 廃止予定で、無視される。category_id を使う
+	Category string   `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Tags     []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	// update_mask は更新するフィールド（title, content, tags, category_id）。
+	// category は category_id から決まるので指定できない。
+	// "*" を指定すると全フィールドを置き換える。省略した場合は category_id 以外を置き換え、
+	// カテゴリはそのまま残す
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,6,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// expected_version を指定すると、ノートがそのバージョンのときだけ更新する。
+	// 一致しない場合は ABORTED を返す。0 は確認しない
+	ExpectedVersion int64 `protobuf:"varint,7,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	// 空にすると未分類に戻す
+	CategoryId    string `protobuf:"bytes,8,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNoteRequest) Reset() {
+	*x = UpdateNoteRequest{}
+	mi := &file_proto_api_note_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNoteRequest) ProtoMessage() {}
+
+func (x *UpdateNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNoteRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateNoteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateNoteRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateNoteRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UpdateNoteRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *UpdateNoteRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateNoteRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateNoteRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateNoteRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+type UpdateNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          *Note                  `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNoteResponse) Reset() {
+	*x = UpdateNoteResponse{}
+	mi := &file_proto_api_note_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNoteResponse) ProtoMessage() {}
+
+func (x *UpdateNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNoteResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateNoteResponse) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+type DeleteNoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// expected_version を指定すると、ノートがそのバージョンのときだけ削除する。
+	// 一致しない場合は ABORTED を返す。0 は確認しない
+	ExpectedVersion int64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteNoteRequest) Reset() {
+	*x = DeleteNoteRequest{}
+	mi := &file_proto_api_note_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNoteRequest) ProtoMessage() {}
+
+func (x *DeleteNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNoteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeleteNoteRequest) GetId() string {
 	if x != nil {
-		return x.Id
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteNoteRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type DeleteNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNoteResponse) Reset() {
+	*x = DeleteNoteResponse{}
+	mi := &file_proto_api_note_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNoteResponse) ProtoMessage() {}
+
+func (x *DeleteNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNoteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteNoteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListDeletedNotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedNotesRequest) Reset() {
+	*x = ListDeletedNotesRequest{}
+	mi := &file_proto_api_note_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedNotesRequest) ProtoMessage() {}
+
+func (x *ListDeletedNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedNotesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedNotesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListDeletedNotesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDeletedNotesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListDeletedNotesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notes         []*Note                `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedNotesResponse) Reset() {
+	*x = ListDeletedNotesResponse{}
+	mi := &file_proto_api_note_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedNotesResponse) ProtoMessage() {}
+
+func (x *ListDeletedNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedNotesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedNotesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListDeletedNotesResponse) GetNotes() []*Note {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *ListDeletedNotesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type RestoreNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreNoteRequest) Reset() {
+	*x = RestoreNoteRequest{}
+	mi := &file_proto_api_note_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreNoteRequest) ProtoMessage() {}
+
+func (x *RestoreNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreNoteRequest.ProtoReflect.Descriptor instead.
+func (*RestoreNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RestoreNoteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RestoreNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          *Note                  `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreNoteResponse) Reset() {
+	*x = RestoreNoteResponse{}
+	mi := &file_proto_api_note_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreNoteResponse) ProtoMessage() {}
+
+func (x *RestoreNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreNoteResponse.ProtoReflect.Descriptor instead.
+func (*RestoreNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RestoreNoteResponse) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+type PurgeNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeNoteRequest) Reset() {
+	*x = PurgeNoteRequest{}
+	mi := &file_proto_api_note_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeNoteRequest) ProtoMessage() {}
+
+func (x *PurgeNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeNoteRequest.ProtoReflect.Descriptor instead.
+func (*PurgeNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PurgeNoteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PurgeNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeNoteResponse) Reset() {
+	*x = PurgeNoteResponse{}
+	mi := &file_proto_api_note_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeNoteResponse) ProtoMessage() {}
+
+func (x *PurgeNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeNoteResponse.ProtoReflect.Descriptor instead.
+func (*PurgeNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PurgeNoteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type NoteShare struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          ShareRole              `protobuf:"varint,3,opt,name=role,proto3,enum=note.ShareRole" json:"role,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteShare) Reset() {
+	*x = NoteShare{}
+	mi := &file_proto_api_note_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteShare) ProtoMessage() {}
+
+func (x *NoteShare) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteShare.ProtoReflect.Descriptor instead.
+func (*NoteShare) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *NoteShare) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *NoteShare) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *NoteShare) GetRole() ShareRole {
+	if x != nil {
+		return x.Role
+	}
+	return ShareRole_SHARE_ROLE_UNSPECIFIED
+}
+
+func (x *NoteShare) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *NoteShare) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ShareNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          ShareRole              `protobuf:"varint,3,opt,name=role,proto3,enum=note.ShareRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareNoteRequest) Reset() {
+	*x = ShareNoteRequest{}
+	mi := &file_proto_api_note_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareNoteRequest) ProtoMessage() {}
+
+func (x *ShareNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareNoteRequest.ProtoReflect.Descriptor instead.
+func (*ShareNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ShareNoteRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *ShareNoteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ShareNoteRequest) GetRole() ShareRole {
+	if x != nil {
+		return x.Role
+	}
+	return ShareRole_SHARE_ROLE_UNSPECIFIED
+}
+
+type ShareNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Share         *NoteShare             `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareNoteResponse) Reset() {
+	*x = ShareNoteResponse{}
+	mi := &file_proto_api_note_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareNoteResponse) ProtoMessage() {}
+
+func (x *ShareNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareNoteResponse.ProtoReflect.Descriptor instead.
+func (*ShareNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ShareNoteResponse) GetShare() *NoteShare {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+type UnshareNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnshareNoteRequest) Reset() {
+	*x = UnshareNoteRequest{}
+	mi := &file_proto_api_note_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnshareNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareNoteRequest) ProtoMessage() {}
+
+func (x *UnshareNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareNoteRequest.ProtoReflect.Descriptor instead.
+func (*UnshareNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UnshareNoteRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *UnshareNoteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnshareNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnshareNoteResponse) Reset() {
+	*x = UnshareNoteResponse{}
+	mi := &file_proto_api_note_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnshareNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareNoteResponse) ProtoMessage() {}
+
+func (x *UnshareNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareNoteResponse.ProtoReflect.Descriptor instead.
+func (*UnshareNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UnshareNoteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListNoteSharesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNoteSharesRequest) Reset() {
+	*x = ListNoteSharesRequest{}
+	mi := &file_proto_api_note_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNoteSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNoteSharesRequest) ProtoMessage() {}
+
+func (x *ListNoteSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNoteSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListNoteSharesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListNoteSharesRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+type ListNoteSharesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shares        []*NoteShare           `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNoteSharesResponse) Reset() {
+	*x = ListNoteSharesResponse{}
+	mi := &file_proto_api_note_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNoteSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNoteSharesResponse) ProtoMessage() {}
+
+func (x *ListNoteSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNoteSharesResponse.ProtoReflect.Descriptor instead.
+func (*ListNoteSharesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListNoteSharesResponse) GetShares() []*NoteShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type ListSharedWithMeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharedWithMeRequest) Reset() {
+	*x = ListSharedWithMeRequest{}
+	mi := &file_proto_api_note_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharedWithMeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedWithMeRequest) ProtoMessage() {}
+
+func (x *ListSharedWithMeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedWithMeRequest.ProtoReflect.Descriptor instead.
+func (*ListSharedWithMeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListSharedWithMeRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSharedWithMeRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SharedNote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          *Note                  `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	Role          ShareRole              `protobuf:"varint,2,opt,name=role,proto3,enum=note.ShareRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedNote) Reset() {
+	*x = SharedNote{}
+	mi := &file_proto_api_note_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedNote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedNote) ProtoMessage() {}
+
+func (x *SharedNote) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedNote.ProtoReflect.Descriptor instead.
+func (*SharedNote) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SharedNote) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+func (x *SharedNote) GetRole() ShareRole {
+	if x != nil {
+		return x.Role
+	}
+	return ShareRole_SHARE_ROLE_UNSPECIFIED
+}
+
+type ListSharedWithMeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notes         []*SharedNote          `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharedWithMeResponse) Reset() {
+	*x = ListSharedWithMeResponse{}
+	mi := &file_proto_api_note_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharedWithMeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedWithMeResponse) ProtoMessage() {}
+
+func (x *ListSharedWithMeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedWithMeResponse.ProtoReflect.Descriptor instead.
+func (*ListSharedWithMeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListSharedWithMeResponse) GetNotes() []*SharedNote {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *ListSharedWithMeResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// NoteRevision は操作した時点のノートのスナップショット
+type NoteRevision struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NoteId string                 `protobuf:"bytes,2,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	// 操作後のノートのバージョン
+	Version int64          `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Action  RevisionAction `protobuf:"varint,4,opt,name=action,proto3,enum=note.RevisionAction" json:"action,omitempty"`
+	// 操作したユーザーの ID。認証が無効な場合は空
+	EditorId  string                 `protobuf:"bytes,5,opt,name=editor_id,json=editorId,proto3" json:"editor_id,omitempty"`
+	Title     string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	Content   string                 `protobuf:"bytes,7,opt,name=content,proto3" json:"content,omitempty"`
+	Category  string                 `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
+	Tags      []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// 記録した時点のカテゴリの ID。カテゴリを削除しても残る
+	CategoryId    string `protobuf:"bytes,11,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteRevision) Reset() {
+	*x = NoteRevision{}
+	mi := &file_proto_api_note_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteRevision) ProtoMessage() {}
+
+func (x *NoteRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteRevision.ProtoReflect.Descriptor instead.
+func (*NoteRevision) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *NoteRevision) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NoteRevision) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *NoteRevision) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *NoteRevision) GetAction() RevisionAction {
+	if x != nil {
+		return x.Action
+	}
+	return RevisionAction_REVISION_ACTION_UNSPECIFIED
+}
+
+func (x *NoteRevision) GetEditorId() string {
+	if x != nil {
+		return x.EditorId
+	}
+	return ""
+}
+
+func (x *NoteRevision) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NoteRevision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *NoteRevision) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *NoteRevision) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *NoteRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *NoteRevision) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+type ListNoteRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNoteRevisionsRequest) Reset() {
+	*x = ListNoteRevisionsRequest{}
+	mi := &file_proto_api_note_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNoteRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNoteRevisionsRequest) ProtoMessage() {}
+
+func (x *ListNoteRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNoteRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListNoteRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListNoteRevisionsRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *ListNoteRevisionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListNoteRevisionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListNoteRevisionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 新しい順
+	Revisions     []*NoteRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	TotalCount    int32           `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNoteRevisionsResponse) Reset() {
+	*x = ListNoteRevisionsResponse{}
+	mi := &file_proto_api_note_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNoteRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNoteRevisionsResponse) ProtoMessage() {}
+
+func (x *ListNoteRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNoteRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListNoteRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListNoteRevisionsResponse) GetRevisions() []*NoteRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListNoteRevisionsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetNoteRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	RevisionId    string                 `protobuf:"bytes,2,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNoteRevisionRequest) Reset() {
+	*x = GetNoteRevisionRequest{}
+	mi := &file_proto_api_note_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNoteRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNoteRevisionRequest) ProtoMessage() {}
+
+func (x *GetNoteRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNoteRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetNoteRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetNoteRevisionRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *GetNoteRevisionRequest) GetRevisionId() string {
+	if x != nil {
+		return x.RevisionId
+	}
+	return ""
+}
+
+type GetNoteRevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      *NoteRevision          `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNoteRevisionResponse) Reset() {
+	*x = GetNoteRevisionResponse{}
+	mi := &file_proto_api_note_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNoteRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNoteRevisionResponse) ProtoMessage() {}
+
+func (x *GetNoteRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNoteRevisionResponse.ProtoReflect.Descriptor instead.
+func (*GetNoteRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetNoteRevisionResponse) GetRevision() *NoteRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+type DiffNoteRevisionsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NoteId         string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	FromRevisionId string                 `protobuf:"bytes,2,opt,name=from_revision_id,json=fromRevisionId,proto3" json:"from_revision_id,omitempty"`
+	// 省略すると現在のノートと比較する
+	ToRevisionId  string `protobuf:"bytes,3,opt,name=to_revision_id,json=toRevisionId,proto3" json:"to_revision_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffNoteRevisionsRequest) Reset() {
+	*x = DiffNoteRevisionsRequest{}
+	mi := &file_proto_api_note_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffNoteRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffNoteRevisionsRequest) ProtoMessage() {}
+
+func (x *DiffNoteRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffNoteRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffNoteRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DiffNoteRevisionsRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *DiffNoteRevisionsRequest) GetFromRevisionId() string {
+	if x != nil {
+		return x.FromRevisionId
+	}
+	return ""
+}
+
+func (x *DiffNoteRevisionsRequest) GetToRevisionId() string {
+	if x != nil {
+		return x.ToRevisionId
+	}
+	return ""
+}
+
+type DiffLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Op            DiffOp                 `protobuf:"varint,1,opt,name=op,proto3,enum=note.DiffOp" json:"op,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffLine) Reset() {
+	*x = DiffLine{}
+	mi := &file_proto_api_note_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffLine) ProtoMessage() {}
+
+func (x *DiffLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffLine.ProtoReflect.Descriptor instead.
+func (*DiffLine) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DiffLine) GetOp() DiffOp {
+	if x != nil {
+		return x.Op
+	}
+	return DiffOp_DIFF_OP_UNSPECIFIED
+}
+
+func (x *DiffLine) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type DiffNoteRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lines         []*DiffLine            `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffNoteRevisionsResponse) Reset() {
+	*x = DiffNoteRevisionsResponse{}
+	mi := &file_proto_api_note_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffNoteRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffNoteRevisionsResponse) ProtoMessage() {}
+
+func (x *DiffNoteRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffNoteRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffNoteRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DiffNoteRevisionsResponse) GetLines() []*DiffLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type RestoreNoteRevisionRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	NoteId     string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	RevisionId string                 `protobuf:"bytes,2,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	// 0 より大きい場合、ノートがこのバージョンのときだけ復元する
+	ExpectedVersion int64 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RestoreNoteRevisionRequest) Reset() {
+	*x = RestoreNoteRevisionRequest{}
+	mi := &file_proto_api_note_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreNoteRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreNoteRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreNoteRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreNoteRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreNoteRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RestoreNoteRevisionRequest) GetNoteId() string {
+	if x != nil {
+		return x.NoteId
+	}
+	return ""
+}
+
+func (x *RestoreNoteRevisionRequest) GetRevisionId() string {
+	if x != nil {
+		return x.RevisionId
+	}
+	return ""
+}
+
+func (x *RestoreNoteRevisionRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type RestoreNoteRevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          *Note                  `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreNoteRevisionResponse) Reset() {
+	*x = RestoreNoteRevisionResponse{}
+	mi := &file_proto_api_note_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreNoteRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreNoteRevisionResponse) ProtoMessage() {}
+
+func (x *RestoreNoteRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreNoteRevisionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreNoteRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RestoreNoteRevisionResponse) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+type Category struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 親カテゴリの ID。ルートのカテゴリでは空
+	ParentId      string                 `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_proto_api_note_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Category) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Category) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Category) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CategoryNode はカテゴリの木の節
+type CategoryNode struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Category *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	// 名前順
+	Children      []*CategoryNode `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryNode) Reset() {
+	*x = CategoryNode{}
+	mi := &file_proto_api_note_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryNode) ProtoMessage() {}
+
+func (x *CategoryNode) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryNode.ProtoReflect.Descriptor instead.
+func (*CategoryNode) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CategoryNode) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *CategoryNode) GetChildren() []*CategoryNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type CreateCategoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 空ならルートに作成する
+	ParentId      string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_proto_api_note_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type CreateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_proto_api_note_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type ListCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_proto_api_note_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{45}
+}
+
+type ListCategoriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ルートのカテゴリ。名前順
+	Roots         []*CategoryNode `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_proto_api_note_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListCategoriesResponse) GetRoots() []*CategoryNode {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
+type RenameCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameCategoryRequest) Reset() {
+	*x = RenameCategoryRequest{}
+	mi := &file_proto_api_note_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameCategoryRequest) ProtoMessage() {}
+
+func (x *RenameCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameCategoryRequest.ProtoReflect.Descriptor instead.
+func (*RenameCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RenameCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RenameCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameCategoryResponse) Reset() {
+	*x = RenameCategoryResponse{}
+	mi := &file_proto_api_note_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameCategoryResponse) ProtoMessage() {}
+
+func (x *RenameCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameCategoryResponse.ProtoReflect.Descriptor instead.
+func (*RenameCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RenameCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type MoveCategoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 空ならルートに移動する
+	ParentId      string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCategoryRequest) Reset() {
+	*x = MoveCategoryRequest{}
+	mi := &file_proto_api_note_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCategoryRequest) ProtoMessage() {}
+
+func (x *MoveCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCategoryRequest.ProtoReflect.Descriptor instead.
+func (*MoveCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MoveCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveCategoryRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type MoveCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCategoryResponse) Reset() {
+	*x = MoveCategoryResponse{}
+	mi := &file_proto_api_note_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCategoryResponse) ProtoMessage() {}
+
+func (x *MoveCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCategoryResponse.ProtoReflect.Descriptor instead.
+func (*MoveCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *MoveCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type DeleteCategoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 削除するカテゴリのノートの付け替え先。空なら未分類にする
+	ReassignToId  string `protobuf:"bytes,2,opt,name=reassign_to_id,json=reassignToId,proto3" json:"reassign_to_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_proto_api_note_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteCategoryRequest) GetReassignToId() string {
+	if x != nil {
+		return x.ReassignToId
+	}
+	return ""
+}
+
+type DeleteCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_proto_api_note_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteCategoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type TagCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// タグが付いたノートの数。ゴミ箱のノートは数えない
+	NoteCount     int64 `protobuf:"varint,2,opt,name=note_count,json=noteCount,proto3" json:"note_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagCount) Reset() {
+	*x = TagCount{}
+	mi := &file_proto_api_note_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagCount) ProtoMessage() {}
+
+func (x *TagCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagCount.ProtoReflect.Descriptor instead.
+func (*TagCount) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *TagCount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagCount) GetNoteCount() int64 {
+	if x != nil {
+		return x.NoteCount
+	}
+	return 0
+}
+
+type ListTagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// prefix を指定すると、その文字列で始まるタグだけを返す
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// limit は返すタグの数。省略時と上限は 100
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_proto_api_note_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListTagsRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
 	}
 	return ""
 }
 
-type DeleteNoteResponse struct {
+func (x *ListTagsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListTagsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Tags          []*TagCount            `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteNoteResponse) Reset() {
-	*x = DeleteNoteResponse{}
-	mi := &file_proto_api_note_proto_msgTypes[10]
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_proto_api_note_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteNoteResponse) String() string {
+func (x *ListTagsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteNoteResponse) ProtoMessage() {}
+func (*ListTagsResponse) ProtoMessage() {}
 
-func (x *DeleteNoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_note_proto_msgTypes[10]
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -630,23 +3359,305 @@ func (x *DeleteNoteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteNoteResponse.ProtoReflect.Descriptor instead.
-func (*DeleteNoteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_note_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *DeleteNoteResponse) GetSuccess() bool {
+func (x *ListTagsResponse) GetTags() []*TagCount {
 	if x != nil {
-		return x.Success
+		return x.Tags
 	}
-	return false
+	return nil
+}
+
+type RenameTagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Tag   string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// すでにあるタグを指定すると MergeTags と同じく統合する
+	NewTag        string `protobuf:"bytes,2,opt,name=new_tag,json=newTag,proto3" json:"new_tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagRequest) Reset() {
+	*x = RenameTagRequest{}
+	mi := &file_proto_api_note_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagRequest) ProtoMessage() {}
+
+func (x *RenameTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameTagRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RenameTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *RenameTagRequest) GetNewTag() string {
+	if x != nil {
+		return x.NewTag
+	}
+	return ""
+}
+
+type RenameTagResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 書き換えたノートの数
+	AffectedNotes int32 `protobuf:"varint,1,opt,name=affected_notes,json=affectedNotes,proto3" json:"affected_notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagResponse) Reset() {
+	*x = RenameTagResponse{}
+	mi := &file_proto_api_note_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagResponse) ProtoMessage() {}
+
+func (x *RenameTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagResponse.ProtoReflect.Descriptor instead.
+func (*RenameTagResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RenameTagResponse) GetAffectedNotes() int32 {
+	if x != nil {
+		return x.AffectedNotes
+	}
+	return 0
+}
+
+type MergeTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceTags    []string               `protobuf:"bytes,1,rep,name=source_tags,json=sourceTags,proto3" json:"source_tags,omitempty"`
+	TargetTag     string                 `protobuf:"bytes,2,opt,name=target_tag,json=targetTag,proto3" json:"target_tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeTagsRequest) Reset() {
+	*x = MergeTagsRequest{}
+	mi := &file_proto_api_note_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTagsRequest) ProtoMessage() {}
+
+func (x *MergeTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTagsRequest.ProtoReflect.Descriptor instead.
+func (*MergeTagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *MergeTagsRequest) GetSourceTags() []string {
+	if x != nil {
+		return x.SourceTags
+	}
+	return nil
+}
+
+func (x *MergeTagsRequest) GetTargetTag() string {
+	if x != nil {
+		return x.TargetTag
+	}
+	return ""
+}
+
+type MergeTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AffectedNotes int32                  `protobuf:"varint,1,opt,name=affected_notes,json=affectedNotes,proto3" json:"affected_notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeTagsResponse) Reset() {
+	*x = MergeTagsResponse{}
+	mi := &file_proto_api_note_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTagsResponse) ProtoMessage() {}
+
+func (x *MergeTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTagsResponse.ProtoReflect.Descriptor instead.
+func (*MergeTagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *MergeTagsResponse) GetAffectedNotes() int32 {
+	if x != nil {
+		return x.AffectedNotes
+	}
+	return 0
+}
+
+type DeleteTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagRequest) Reset() {
+	*x = DeleteTagRequest{}
+	mi := &file_proto_api_note_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagRequest) ProtoMessage() {}
+
+func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeleteTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type DeleteTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AffectedNotes int32                  `protobuf:"varint,1,opt,name=affected_notes,json=affectedNotes,proto3" json:"affected_notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagResponse) Reset() {
+	*x = DeleteTagResponse{}
+	mi := &file_proto_api_note_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagResponse) ProtoMessage() {}
+
+func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_note_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTagResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_note_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeleteTagResponse) GetAffectedNotes() int32 {
+	if x != nil {
+		return x.AffectedNotes
+	}
+	return 0
 }
 
 var File_proto_api_note_proto protoreflect.FileDescriptor
 
 const file_proto_api_note_proto_rawDesc = "" +
 	"\n" +
-	"\x14proto/api/note.proto\x12\x04note\x1a\x1fgoogle/protobuf/timestamp.proto\"\xec\x01\n" +
+	"\x14proto/api/note.proto\x12\x04note\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfd\x02\n" +
 	"\x04Note\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
@@ -656,12 +3667,21 @@ const file_proto_api_note_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"s\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x18\n" +
+	"\aversion\x18\t \x01(\x03R\aversion\x12\x19\n" +
+	"\bowner_id\x18\n" +
+	" \x01(\tR\aownerId\x12\x1f\n" +
+	"\vcategory_id\x18\v \x01(\tR\n" +
+	"categoryId\"\x94\x01\n" +
 	"\x11CreateNoteRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1a\n" +
 	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x12\n" +
-	"\x04tags\x18\x04 \x03(\tR\x04tags\"4\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x1f\n" +
+	"\vcategory_id\x18\x05 \x01(\tR\n" +
+	"categoryId\"4\n" +
 	"\x12CreateNoteResponse\x12\x1e\n" +
 	"\x04note\x18\x01 \x01(\v2\n" +
 	".note.NoteR\x04note\" \n" +
@@ -669,39 +3689,269 @@ const file_proto_api_note_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
 	"\x0fGetNoteResponse\x12\x1e\n" +
 	"\x04note\x18\x01 \x01(\v2\n" +
-	".note.NoteR\x04note\"l\n" +
+	".note.NoteR\x04note\"\x9e\x02\n" +
 	"\x10ListNotesRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1a\n" +
 	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x12\n" +
-	"\x04tags\x18\x04 \x03(\tR\x04tags\"V\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x06 \x01(\tR\aorderBy\x12%\n" +
+	"\x0einclude_shared\x18\a \x01(\bR\rincludeShared\x12\x1f\n" +
+	"\vcategory_id\x18\b \x01(\tR\n" +
+	"categoryId\x12.\n" +
+	"\n" +
+	"tag_filter\x18\t \x01(\v2\x0f.note.TagFilterR\ttagFilter\"C\n" +
+	"\tTagFilter\x12\x10\n" +
+	"\x03all\x18\x01 \x03(\tR\x03all\x12\x10\n" +
+	"\x03any\x18\x02 \x03(\tR\x03any\x12\x12\n" +
+	"\x04none\x18\x03 \x03(\tR\x04none\"~\n" +
 	"\x11ListNotesResponse\x12 \n" +
 	"\x05notes\x18\x01 \x03(\v2\n" +
 	".note.NoteR\x05notes\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"\x83\x01\n" +
+	"totalCount\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xa5\x01\n" +
+	"\x12SearchNotesRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12\x1f\n" +
+	"\vcategory_id\x18\x06 \x01(\tR\n" +
+	"categoryId\"^\n" +
+	"\fSearchResult\x12\x1e\n" +
+	"\x04note\x18\x01 \x01(\v2\n" +
+	".note.NoteR\x04note\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x02R\x04rank\x12\x1a\n" +
+	"\bheadline\x18\x03 \x01(\tR\bheadline\"d\n" +
+	"\x13SearchNotesResponse\x12,\n" +
+	"\aresults\x18\x01 \x03(\v2\x12.note.SearchResultR\aresults\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\x8c\x02\n" +
 	"\x11UpdateNoteRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1a\n" +
 	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x12\n" +
-	"\x04tags\x18\x05 \x03(\tR\x04tags\"4\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12;\n" +
+	"\vupdate_mask\x18\x06 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12)\n" +
+	"\x10expected_version\x18\a \x01(\x03R\x0fexpectedVersion\x12\x1f\n" +
+	"\vcategory_id\x18\b \x01(\tR\n" +
+	"categoryId\"4\n" +
 	"\x12UpdateNoteResponse\x12\x1e\n" +
 	"\x04note\x18\x01 \x01(\v2\n" +
-	".note.NoteR\x04note\"#\n" +
+	".note.NoteR\x04note\"N\n" +
 	"\x11DeleteNoteRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\".\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\".\n" +
 	"\x12DeleteNoteResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xc6\x02\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"C\n" +
+	"\x17ListDeletedNotesRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"]\n" +
+	"\x18ListDeletedNotesResponse\x12 \n" +
+	"\x05notes\x18\x01 \x03(\v2\n" +
+	".note.NoteR\x05notes\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"$\n" +
+	"\x12RestoreNoteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"5\n" +
+	"\x13RestoreNoteResponse\x12\x1e\n" +
+	"\x04note\x18\x01 \x01(\v2\n" +
+	".note.NoteR\x04note\"\"\n" +
+	"\x10PurgeNoteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"-\n" +
+	"\x11PurgeNoteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd8\x01\n" +
+	"\tNoteShare\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12#\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x0f.note.ShareRoleR\x04role\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"i\n" +
+	"\x10ShareNoteRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12#\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x0f.note.ShareRoleR\x04role\":\n" +
+	"\x11ShareNoteResponse\x12%\n" +
+	"\x05share\x18\x01 \x01(\v2\x0f.note.NoteShareR\x05share\"F\n" +
+	"\x12UnshareNoteRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"/\n" +
+	"\x13UnshareNoteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"0\n" +
+	"\x15ListNoteSharesRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\"A\n" +
+	"\x16ListNoteSharesResponse\x12'\n" +
+	"\x06shares\x18\x01 \x03(\v2\x0f.note.NoteShareR\x06shares\"C\n" +
+	"\x17ListSharedWithMeRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"Q\n" +
+	"\n" +
+	"SharedNote\x12\x1e\n" +
+	"\x04note\x18\x01 \x01(\v2\n" +
+	".note.NoteR\x04note\x12#\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x0f.note.ShareRoleR\x04role\"c\n" +
+	"\x18ListSharedWithMeResponse\x12&\n" +
+	"\x05notes\x18\x01 \x03(\v2\x10.note.SharedNoteR\x05notes\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\xd8\x02\n" +
+	"\fNoteRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\anote_id\x18\x02 \x01(\tR\x06noteId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x03R\aversion\x12,\n" +
+	"\x06action\x18\x04 \x01(\x0e2\x14.note.RevisionActionR\x06action\x12\x1b\n" +
+	"\teditor_id\x18\x05 \x01(\tR\beditorId\x12\x14\n" +
+	"\x05title\x18\x06 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\a \x01(\tR\acontent\x12\x1a\n" +
+	"\bcategory\x18\b \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1f\n" +
+	"\vcategory_id\x18\v \x01(\tR\n" +
+	"categoryId\"]\n" +
+	"\x18ListNoteRevisionsRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"n\n" +
+	"\x19ListNoteRevisionsResponse\x120\n" +
+	"\trevisions\x18\x01 \x03(\v2\x12.note.NoteRevisionR\trevisions\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"R\n" +
+	"\x16GetNoteRevisionRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\x12\x1f\n" +
+	"\vrevision_id\x18\x02 \x01(\tR\n" +
+	"revisionId\"I\n" +
+	"\x17GetNoteRevisionResponse\x12.\n" +
+	"\brevision\x18\x01 \x01(\v2\x12.note.NoteRevisionR\brevision\"\x83\x01\n" +
+	"\x18DiffNoteRevisionsRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\x12(\n" +
+	"\x10from_revision_id\x18\x02 \x01(\tR\x0efromRevisionId\x12$\n" +
+	"\x0eto_revision_id\x18\x03 \x01(\tR\ftoRevisionId\"<\n" +
+	"\bDiffLine\x12\x1c\n" +
+	"\x02op\x18\x01 \x01(\x0e2\f.note.DiffOpR\x02op\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"A\n" +
+	"\x19DiffNoteRevisionsResponse\x12$\n" +
+	"\x05lines\x18\x01 \x03(\v2\x0e.note.DiffLineR\x05lines\"\x81\x01\n" +
+	"\x1aRestoreNoteRevisionRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\x12\x1f\n" +
+	"\vrevision_id\x18\x02 \x01(\tR\n" +
+	"revisionId\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"=\n" +
+	"\x1bRestoreNoteRevisionResponse\x12\x1e\n" +
+	"\x04note\x18\x01 \x01(\v2\n" +
+	".note.NoteR\x04note\"\xc1\x01\n" +
+	"\bCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"j\n" +
+	"\fCategoryNode\x12*\n" +
+	"\bcategory\x18\x01 \x01(\v2\x0e.note.CategoryR\bcategory\x12.\n" +
+	"\bchildren\x18\x02 \x03(\v2\x12.note.CategoryNodeR\bchildren\"H\n" +
+	"\x15CreateCategoryRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\"D\n" +
+	"\x16CreateCategoryResponse\x12*\n" +
+	"\bcategory\x18\x01 \x01(\v2\x0e.note.CategoryR\bcategory\"\x17\n" +
+	"\x15ListCategoriesRequest\"B\n" +
+	"\x16ListCategoriesResponse\x12(\n" +
+	"\x05roots\x18\x01 \x03(\v2\x12.note.CategoryNodeR\x05roots\";\n" +
+	"\x15RenameCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"D\n" +
+	"\x16RenameCategoryResponse\x12*\n" +
+	"\bcategory\x18\x01 \x01(\v2\x0e.note.CategoryR\bcategory\"B\n" +
+	"\x13MoveCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\"B\n" +
+	"\x14MoveCategoryResponse\x12*\n" +
+	"\bcategory\x18\x01 \x01(\v2\x0e.note.CategoryR\bcategory\"M\n" +
+	"\x15DeleteCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12$\n" +
+	"\x0ereassign_to_id\x18\x02 \x01(\tR\freassignToId\"2\n" +
+	"\x16DeleteCategoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"=\n" +
+	"\bTagCount\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"note_count\x18\x02 \x01(\x03R\tnoteCount\"?\n" +
+	"\x0fListTagsRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"6\n" +
+	"\x10ListTagsResponse\x12\"\n" +
+	"\x04tags\x18\x01 \x03(\v2\x0e.note.TagCountR\x04tags\"=\n" +
+	"\x10RenameTagRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x17\n" +
+	"\anew_tag\x18\x02 \x01(\tR\x06newTag\":\n" +
+	"\x11RenameTagResponse\x12%\n" +
+	"\x0eaffected_notes\x18\x01 \x01(\x05R\raffectedNotes\"R\n" +
+	"\x10MergeTagsRequest\x12\x1f\n" +
+	"\vsource_tags\x18\x01 \x03(\tR\n" +
+	"sourceTags\x12\x1d\n" +
+	"\n" +
+	"target_tag\x18\x02 \x01(\tR\ttargetTag\":\n" +
+	"\x11MergeTagsResponse\x12%\n" +
+	"\x0eaffected_notes\x18\x01 \x01(\x05R\raffectedNotes\"$\n" +
+	"\x10DeleteTagRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\":\n" +
+	"\x11DeleteTagResponse\x12%\n" +
+	"\x0eaffected_notes\x18\x01 \x01(\x05R\raffectedNotes*o\n" +
+	"\tShareRole\x12\x1a\n" +
+	"\x16SHARE_ROLE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11SHARE_ROLE_VIEWER\x10\x01\x12\x18\n" +
+	"\x14SHARE_ROLE_COMMENTER\x10\x02\x12\x15\n" +
+	"\x11SHARE_ROLE_EDITOR\x10\x03*\xa2\x01\n" +
+	"\x0eRevisionAction\x12\x1f\n" +
+	"\x1bREVISION_ACTION_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16REVISION_ACTION_CREATE\x10\x01\x12\x1a\n" +
+	"\x16REVISION_ACTION_UPDATE\x10\x02\x12\x1a\n" +
+	"\x16REVISION_ACTION_DELETE\x10\x03\x12\x1b\n" +
+	"\x17REVISION_ACTION_RESTORE\x10\x04*\\\n" +
+	"\x06DiffOp\x12\x17\n" +
+	"\x13DIFF_OP_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rDIFF_OP_EQUAL\x10\x01\x12\x12\n" +
+	"\x0eDIFF_OP_INSERT\x10\x02\x12\x12\n" +
+	"\x0eDIFF_OP_DELETE\x10\x032\xc9\x0e\n" +
 	"\vNoteService\x12?\n" +
 	"\n" +
 	"CreateNote\x12\x17.note.CreateNoteRequest\x1a\x18.note.CreateNoteResponse\x126\n" +
 	"\aGetNote\x12\x14.note.GetNoteRequest\x1a\x15.note.GetNoteResponse\x12<\n" +
-	"\tListNotes\x12\x16.note.ListNotesRequest\x1a\x17.note.ListNotesResponse\x12?\n" +
+	"\tListNotes\x12\x16.note.ListNotesRequest\x1a\x17.note.ListNotesResponse\x12B\n" +
+	"\vSearchNotes\x12\x18.note.SearchNotesRequest\x1a\x19.note.SearchNotesResponse\x12?\n" +
 	"\n" +
 	"UpdateNote\x12\x17.note.UpdateNoteRequest\x1a\x18.note.UpdateNoteResponse\x12?\n" +
 	"\n" +
-	"DeleteNote\x12\x17.note.DeleteNoteRequest\x1a\x18.note.DeleteNoteResponseB\n" +
+	"DeleteNote\x12\x17.note.DeleteNoteRequest\x1a\x18.note.DeleteNoteResponse\x12Q\n" +
+	"\x10ListDeletedNotes\x12\x1d.note.ListDeletedNotesRequest\x1a\x1e.note.ListDeletedNotesResponse\x12B\n" +
+	"\vRestoreNote\x12\x18.note.RestoreNoteRequest\x1a\x19.note.RestoreNoteResponse\x12<\n" +
+	"\tPurgeNote\x12\x16.note.PurgeNoteRequest\x1a\x17.note.PurgeNoteResponse\x12<\n" +
+	"\tShareNote\x12\x16.note.ShareNoteRequest\x1a\x17.note.ShareNoteResponse\x12B\n" +
+	"\vUnshareNote\x12\x18.note.UnshareNoteRequest\x1a\x19.note.UnshareNoteResponse\x12K\n" +
+	"\x0eListNoteShares\x12\x1b.note.ListNoteSharesRequest\x1a\x1c.note.ListNoteSharesResponse\x12Q\n" +
+	"\x10ListSharedWithMe\x12\x1d.note.ListSharedWithMeRequest\x1a\x1e.note.ListSharedWithMeResponse\x12T\n" +
+	"\x11ListNoteRevisions\x12\x1e.note.ListNoteRevisionsRequest\x1a\x1f.note.ListNoteRevisionsResponse\x12N\n" +
+	"\x0fGetNoteRevision\x12\x1c.note.GetNoteRevisionRequest\x1a\x1d.note.GetNoteRevisionResponse\x12T\n" +
+	"\x11DiffNoteRevisions\x12\x1e.note.DiffNoteRevisionsRequest\x1a\x1f.note.DiffNoteRevisionsResponse\x12Z\n" +
+	"\x13RestoreNoteRevision\x12 .note.RestoreNoteRevisionRequest\x1a!.note.RestoreNoteRevisionResponse\x12K\n" +
+	"\x0eCreateCategory\x12\x1b.note.CreateCategoryRequest\x1a\x1c.note.CreateCategoryResponse\x12K\n" +
+	"\x0eListCategories\x12\x1b.note.ListCategoriesRequest\x1a\x1c.note.ListCategoriesResponse\x12K\n" +
+	"\x0eRenameCategory\x12\x1b.note.RenameCategoryRequest\x1a\x1c.note.RenameCategoryResponse\x12E\n" +
+	"\fMoveCategory\x12\x19.note.MoveCategoryRequest\x1a\x1a.note.MoveCategoryResponse\x12K\n" +
+	"\x0eDeleteCategory\x12\x1b.note.DeleteCategoryRequest\x1a\x1c.note.DeleteCategoryResponse\x129\n" +
+	"\bListTags\x12\x15.note.ListTagsRequest\x1a\x16.note.ListTagsResponse\x12<\n" +
+	"\tRenameTag\x12\x16.note.RenameTagRequest\x1a\x17.note.RenameTagResponse\x12<\n" +
+	"\tMergeTags\x12\x16.note.MergeTagsRequest\x1a\x17.note.MergeTagsResponse\x12<\n" +
+	"\tDeleteTag\x12\x16.note.DeleteTagRequest\x1a\x17.note.DeleteTagResponseB\n" +
 	"Z\bapp/grpcb\x06proto3"
 
 var (
@@ -716,43 +3966,173 @@ func file_proto_api_note_proto_rawDescGZIP() []byte {
 	return file_proto_api_note_proto_rawDescData
 }
 
-var file_proto_api_note_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_api_note_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proto_api_note_proto_msgTypes = make([]protoimpl.MessageInfo, 62)
 var file_proto_api_note_proto_goTypes = []any{
-	(*Note)(nil),                  // 0: note.Note
-	(*CreateNoteRequest)(nil),     // 1: note.CreateNoteRequest
-	(*CreateNoteResponse)(nil),    // 2: note.CreateNoteResponse
-	(*GetNoteRequest)(nil),        // 3: note.GetNoteRequest
-	(*GetNoteResponse)(nil),       // 4: note.GetNoteResponse
-	(*ListNotesRequest)(nil),      // 5: note.ListNotesRequest
-	(*ListNotesResponse)(nil),     // 6: note.ListNotesResponse
-	(*UpdateNoteRequest)(nil),     // 7: note.UpdateNoteRequest
-	(*UpdateNoteResponse)(nil),    // 8: note.UpdateNoteResponse
-	(*DeleteNoteRequest)(nil),     // 9: note.DeleteNoteRequest
-	(*DeleteNoteResponse)(nil),    // 10: note.DeleteNoteResponse
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(ShareRole)(0),                      // 0: note.ShareRole
+	(RevisionAction)(0),                 // 1: note.RevisionAction
+	(DiffOp)(0),                         // 2: note.DiffOp
+	(*Note)(nil),                        // 3: note.Note
+	(*CreateNoteRequest)(nil),           // 4: note.CreateNoteRequest
+	(*CreateNoteResponse)(nil),          // 5: note.CreateNoteResponse
+	(*GetNoteRequest)(nil),              // 6: note.GetNoteRequest
+	(*GetNoteResponse)(nil),             // 7: note.GetNoteResponse
+	(*ListNotesRequest)(nil),            // 8: note.ListNotesRequest
+	(*TagFilter)(nil),                   // 9: note.TagFilter
+	(*ListNotesResponse)(nil),           // 10: note.ListNotesResponse
+	(*SearchNotesRequest)(nil),          // 11: note.SearchNotesRequest
+	(*SearchResult)(nil),                // 12: note.SearchResult
+	(*SearchNotesResponse)(nil),         // 13: note.SearchNotesResponse
+	(*UpdateNoteRequest)(nil),           // 14: note.UpdateNoteRequest
+	(*UpdateNoteResponse)(nil),          // 15: note.UpdateNoteResponse
+	(*DeleteNoteRequest)(nil),           // 16: note.DeleteNoteRequest
+	(*DeleteNoteResponse)(nil),          // 17: note.DeleteNoteResponse
+	(*ListDeletedNotesRequest)(nil),     // 18: note.ListDeletedNotesRequest
+	(*ListDeletedNotesResponse)(nil),    // 19: note.ListDeletedNotesResponse
+	(*RestoreNoteRequest)(nil),          // 20: note.RestoreNoteRequest
+	(*RestoreNoteResponse)(nil),         // 21: note.RestoreNoteResponse
+	(*PurgeNoteRequest)(nil),            // 22: note.PurgeNoteRequest
+	(*PurgeNoteResponse)(nil),           // 23: note.PurgeNoteResponse
+	(*NoteShare)(nil),                   // 24: note.NoteShare
+	(*ShareNoteRequest)(nil),            // 25: note.ShareNoteRequest
+	(*ShareNoteResponse)(nil),           // 26: note.ShareNoteResponse
+	(*UnshareNoteRequest)(nil),          // 27: note.UnshareNoteRequest
+	(*UnshareNoteResponse)(nil),         // 28: note.UnshareNoteResponse
+	(*ListNoteSharesRequest)(nil),       // 29: note.ListNoteSharesRequest
+	(*ListNoteSharesResponse)(nil),      // 30: note.ListNoteSharesResponse
+	(*ListSharedWithMeRequest)(nil),     // 31: note.ListSharedWithMeRequest
+	(*SharedNote)(nil),                  // 32: note.SharedNote
+	(*ListSharedWithMeResponse)(nil),    // 33: note.ListSharedWithMeResponse
+	(*NoteRevision)(nil),                // 34: note.NoteRevision
+	(*ListNoteRevisionsRequest)(nil),    // 35: note.ListNoteRevisionsRequest
+	(*ListNoteRevisionsResponse)(nil),   // 36: note.ListNoteRevisionsResponse
+	(*GetNoteRevisionRequest)(nil),      // 37: note.GetNoteRevisionRequest
+	(*GetNoteRevisionResponse)(nil),     // 38: note.GetNoteRevisionResponse
+	(*DiffNoteRevisionsRequest)(nil),    // 39: note.DiffNoteRevisionsRequest
+	(*DiffLine)(nil),                    // 40: note.DiffLine
+	(*DiffNoteRevisionsResponse)(nil),   // 41: note.DiffNoteRevisionsResponse
+	(*RestoreNoteRevisionRequest)(nil),  // 42: note.RestoreNoteRevisionRequest
+	(*RestoreNoteRevisionResponse)(nil), // 43: note.RestoreNoteRevisionResponse
+	(*Category)(nil),                    // 44: note.Category
+	(*CategoryNode)(nil),                // 45: note.CategoryNode
+	(*CreateCategoryRequest)(nil),       // 46: note.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),      // 47: note.CreateCategoryResponse
+	(*ListCategoriesRequest)(nil),       // 48: note.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),      // 49: note.ListCategoriesResponse
+	(*RenameCategoryRequest)(nil),       // 50: note.RenameCategoryRequest
+	(*RenameCategoryResponse)(nil),      // 51: note.RenameCategoryResponse
+	(*MoveCategoryRequest)(nil),         // 52: note.MoveCategoryRequest
+	(*MoveCategoryResponse)(nil),        // 53: note.MoveCategoryResponse
+	(*DeleteCategoryRequest)(nil),       // 54: note.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil),      // 55: note.DeleteCategoryResponse
+	(*TagCount)(nil),                    // 56: note.TagCount
+	(*ListTagsRequest)(nil),             // 57: note.ListTagsRequest
+	(*ListTagsResponse)(nil),            // 58: note.ListTagsResponse
+	(*RenameTagRequest)(nil),            // 59: note.RenameTagRequest
+	(*RenameTagResponse)(nil),           // 60: note.RenameTagResponse
+	(*MergeTagsRequest)(nil),            // 61: note.MergeTagsRequest
+	(*MergeTagsResponse)(nil),           // 62: note.MergeTagsResponse
+	(*DeleteTagRequest)(nil),            // 63: note.DeleteTagRequest
+	(*DeleteTagResponse)(nil),           // 64: note.DeleteTagResponse
+	(*timestamppb.Timestamp)(nil),       // 65: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),       // 66: google.protobuf.FieldMask
 }
 var file_proto_api_note_proto_depIdxs = []int32{
-	11, // 0: note.Note.created_at:type_name -> google.protobuf.Timestamp
-	11, // 1: note.Note.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: note.CreateNoteResponse.note:type_name -> note.Note
-	0,  // 3: note.GetNoteResponse.note:type_name -> note.Note
-	0,  // 4: note.ListNotesResponse.notes:type_name -> note.Note
-	0,  // 5: note.UpdateNoteResponse.note:type_name -> note.Note
-	1,  // 6: note.NoteService.CreateNote:input_type -> note.CreateNoteRequest
-	3,  // 7: note.NoteService.GetNote:input_type -> note.GetNoteRequest
-	5,  // 8: note.NoteService.ListNotes:input_type -> note.ListNotesRequest
-	7,  // 9: note.NoteService.UpdateNote:input_type -> note.UpdateNoteRequest
-	9,  // 10: note.NoteService.DeleteNote:input_type -> note.DeleteNoteRequest
-	2,  // 11: note.NoteService.CreateNote:output_type -> note.CreateNoteResponse
-	4,  // 12: note.NoteService.GetNote:output_type -> note.GetNoteResponse
-	6,  // 13: note.NoteService.ListNotes:output_type -> note.ListNotesResponse
-	8,  // 14: note.NoteService.UpdateNote:output_type -> note.UpdateNoteResponse
-	10, // 15: note.NoteService.DeleteNote:output_type -> note.DeleteNoteResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	65, // 0: note.Note.created_at:type_name -> google.protobuf.Timestamp
+	65, // 1: note.Note.updated_at:type_name -> google.protobuf.Timestamp
+	65, // 2: note.Note.deleted_at:type_name -> google.protobuf.Timestamp
+	3,  // 3: note.CreateNoteResponse.note:type_name -> note.Note
+	3,  // 4: note.GetNoteResponse.note:type_name -> note.Note
+	9,  // 5: note.ListNotesRequest.tag_filter:type_name -> note.TagFilter
+	3,  // 6: note.ListNotesResponse.notes:type_name -> note.Note
+	3,  // 7: note.SearchResult.note:type_name -> note.Note
+	12, // 8: note.SearchNotesResponse.results:type_name -> note.SearchResult
+	66, // 9: note.UpdateNoteRequest.update_mask:type_name -> google.protobuf.FieldMask
+	3,  // 10: note.UpdateNoteResponse.note:type_name -> note.Note
+	3,  // 11: note.ListDeletedNotesResponse.notes:type_name -> note.Note
+	3,  // 12: note.RestoreNoteResponse.note:type_name -> note.Note
+	0,  // 13: note.NoteShare.role:type_name -> note.ShareRole
+	65, // 14: note.NoteShare.created_at:type_name -> google.protobuf.Timestamp
+	65, // 15: note.NoteShare.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 16: note.ShareNoteRequest.role:type_name -> note.ShareRole
+	24, // 17: note.ShareNoteResponse.share:type_name -> note.NoteShare
+	24, // 18: note.ListNoteSharesResponse.shares:type_name -> note.NoteShare
+	3,  // 19: note.SharedNote.note:type_name -> note.Note
+	0,  // 20: note.SharedNote.role:type_name -> note.ShareRole
+	32, // 21: note.ListSharedWithMeResponse.notes:type_name -> note.SharedNote
+	1,  // 22: note.NoteRevision.action:type_name -> note.RevisionAction
+	65, // 23: note.NoteRevision.created_at:type_name -> google.protobuf.Timestamp
+	34, // 24: note.ListNoteRevisionsResponse.revisions:type_name -> note.NoteRevision
+	34, // 25: note.GetNoteRevisionResponse.revision:type_name -> note.NoteRevision
+	2,  // 26: note.DiffLine.op:type_name -> note.DiffOp
+	40, // 27: note.DiffNoteRevisionsResponse.lines:type_name -> note.DiffLine
+	3,  // 28: note.RestoreNoteRevisionResponse.note:type_name -> note.Note
+	65, // 29: note.Category.created_at:type_name -> google.protobuf.Timestamp
+	65, // 30: note.Category.updated_at:type_name -> google.protobuf.Timestamp
+	44, // 31: note.CategoryNode.category:type_name -> note.Category
+	45, // 32: note.CategoryNode.children:type_name -> note.CategoryNode
+	44, // 33: note.CreateCategoryResponse.category:type_name -> note.Category
+	45, // 34: note.ListCategoriesResponse.roots:type_name -> note.CategoryNode
+	44, // 35: note.RenameCategoryResponse.category:type_name -> note.Category
+	44, // 36: note.MoveCategoryResponse.category:type_name -> note.Category
+	56, // 37: note.ListTagsResponse.tags:type_name -> note.TagCount
+	4,  // 38: note.NoteService.CreateNote:input_type -> note.CreateNoteRequest
+	6,  // 39: note.NoteService.GetNote:input_type -> note.GetNoteRequest
+	8,  // 40: note.NoteService.ListNotes:input_type -> note.ListNotesRequest
+	11, // 41: note.NoteService.SearchNotes:input_type -> note.SearchNotesRequest
+	14, // 42: note.NoteService.UpdateNote:input_type -> note.UpdateNoteRequest
+	16, // 43: note.NoteService.DeleteNote:input_type -> note.DeleteNoteRequest
+	18, // 44: note.NoteService.ListDeletedNotes:input_type -> note.ListDeletedNotesRequest
+	20, // 45: note.NoteService.RestoreNote:input_type -> note.RestoreNoteRequest
+	22, // 46: note.NoteService.PurgeNote:input_type -> note.PurgeNoteRequest
+	25, // 47: note.NoteService.ShareNote:input_type -> note.ShareNoteRequest
+	27, // 48: note.NoteService.UnshareNote:input_type -> note.UnshareNoteRequest
+	29, // 49: note.NoteService.ListNoteShares:input_type -> note.ListNoteSharesRequest
+	31, // 50: note.NoteService.ListSharedWithMe:input_type -> note.ListSharedWithMeRequest
+	35, // 51: note.NoteService.ListNoteRevisions:input_type -> note.ListNoteRevisionsRequest
+	37, // 52: note.NoteService.GetNoteRevision:input_type -> note.GetNoteRevisionRequest
+	39, // 53: note.NoteService.DiffNoteRevisions:input_type -> note.DiffNoteRevisionsRequest
+	42, // 54: note.NoteService.RestoreNoteRevision:input_type -> note.RestoreNoteRevisionRequest
+	46, // 55: note.NoteService.CreateCategory:input_type -> note.CreateCategoryRequest
+	48, // 56: note.NoteService.ListCategories:input_type -> note.ListCategoriesRequest
+	50, // 57: note.NoteService.RenameCategory:input_type -> note.RenameCategoryRequest
+	52, // 58: note.NoteService.MoveCategory:input_type -> note.MoveCategoryRequest
+	54, // 59: note.NoteService.DeleteCategory:input_type -> note.DeleteCategoryRequest
+	57, // 60: note.NoteService.ListTags:input_type -> note.ListTagsRequest
+	59, // 61: note.NoteService.RenameTag:input_type -> note.RenameTagRequest
+	61, // 62: note.NoteService.MergeTags:input_type -> note.MergeTagsRequest
+	63, // 63: note.NoteService.DeleteTag:input_type -> note.DeleteTagRequest
+	5,  // 64: note.NoteService.CreateNote:output_type -> note.CreateNoteResponse
+	7,  // 65: note.NoteService.GetNote:output_type -> note.GetNoteResponse
+	10, // 66: note.NoteService.ListNotes:output_type -> note.ListNotesResponse
+	13, // 67: note.NoteService.SearchNotes:output_type -> note.SearchNotesResponse
+	15, // 68: note.NoteService.UpdateNote:output_type -> note.UpdateNoteResponse
+	17, // 69: note.NoteService.DeleteNote:output_type -> note.DeleteNoteResponse
+	19, // 70: note.NoteService.ListDeletedNotes:output_type -> note.ListDeletedNotesResponse
+	21, // 71: note.NoteService.RestoreNote:output_type -> note.RestoreNoteResponse
+	23, // 72: note.NoteService.PurgeNote:output_type -> note.PurgeNoteResponse
+	26, // 73: note.NoteService.ShareNote:output_type -> note.ShareNoteResponse
+	28, // 74: note.NoteService.UnshareNote:output_type -> note.UnshareNoteResponse
+	30, // 75: note.NoteService.ListNoteShares:output_type -> note.ListNoteSharesResponse
+	33, // 76: note.NoteService.ListSharedWithMe:output_type -> note.ListSharedWithMeResponse
+	36, // 77: note.NoteService.ListNoteRevisions:output_type -> note.ListNoteRevisionsResponse
+	38, // 78: note.NoteService.GetNoteRevision:output_type -> note.GetNoteRevisionResponse
+	41, // 79: note.NoteService.DiffNoteRevisions:output_type -> note.DiffNoteRevisionsResponse
+	43, // 80: note.NoteService.RestoreNoteRevision:output_type -> note.RestoreNoteRevisionResponse
+	47, // 81: note.NoteService.CreateCategory:output_type -> note.CreateCategoryResponse
+	49, // 82: note.NoteService.ListCategories:output_type -> note.ListCategoriesResponse
+	51, // 83: note.NoteService.RenameCategory:output_type -> note.RenameCategoryResponse
+	53, // 84: note.NoteService.MoveCategory:output_type -> note.MoveCategoryResponse
+	55, // 85: note.NoteService.DeleteCategory:output_type -> note.DeleteCategoryResponse
+	58, // 86: note.NoteService.ListTags:output_type -> note.ListTagsResponse
+	60, // 87: note.NoteService.RenameTag:output_type -> note.RenameTagResponse
+	62, // 88: note.NoteService.MergeTags:output_type -> note.MergeTagsResponse
+	64, // 89: note.NoteService.DeleteTag:output_type -> note.DeleteTagResponse
+	64, // [64:90] is the sub-list for method output_type
+	38, // [38:64] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_proto_api_note_proto_init() }
@@ -765,13 +4145,14 @@ func file_proto_api_note_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_api_note_proto_rawDesc), len(file_proto_api_note_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      3,
+			NumMessages:   62,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_api_note_proto_goTypes,
 		DependencyIndexes: file_proto_api_note_proto_depIdxs,
+		EnumInfos:         file_proto_api_note_proto_enumTypes,
 		MessageInfos:      file_proto_api_note_proto_msgTypes,
 	}.Build()
 	File_proto_api_note_proto = out.File
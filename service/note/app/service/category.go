@@ -0,0 +1,73 @@
+package service
+
+import (
+	"note/db/model"
+	pb "note/grpc"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxCategoryNameLength は categories.name の varchar(100) に合わせた文字数の上限
+const maxCategoryNameLength = 100
+
+// categoryName は前後の空白を除いたカテゴリ名を返す。空や長すぎる名前は INVALID_ARGUMENT になる
+func categoryName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", status.Error(codes.InvalidArgument, "category name is required")
+	}
+	if utf8.RuneCountInString(name) > maxCategoryNameLength {
+		return "", status.Errorf(codes.InvalidArgument, "category name must be at most %d characters", maxCategoryNameLength)
+	}
+	return name, nil
+}
+
+// optionalID は空文字を nil にする。NULL を許すカラムの ID に使う
+func optionalID(id string) *string {
+	if id == "" {
+		return nil
+	}
+	return &id
+}
+
+// stringValue は nil を空文字にする
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// toProtoCategory はモデルのカテゴリを gRPC のカテゴリに変換する
+func toProtoCategory(category *model.Category) *pb.Category {
+	return &pb.Category{
+		Id:        category.ID,
+		ParentId:  stringValue(category.ParentID),
+		Name:      category.Name,
+		CreatedAt: timestamppb.New(category.CreatedAt),
+		UpdatedAt: timestamppb.New(category.UpdatedAt),
+	}
+}
+
+// categoryTree は名前順のカテゴリ一覧から木を作り、ルートの節を返す
+func categoryTree(categories []*model.Category) []*pb.CategoryNode {
+	nodes := make(map[string]*pb.CategoryNode, len(categories))
+	for _, category := range categories {
+		nodes[category.ID] = &pb.CategoryNode{Category: toProtoCategory(category)}
+	}
+
+	var roots []*pb.CategoryNode
+	for _, category := range categories {
+		node := nodes[category.ID]
+		if parent, ok := nodes[stringValue(category.ParentID)]; ok {
+			parent.Children = append(parent.Children, node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+	return roots
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"note/auth"
+	"note/db/model"
+	pb "note/grpc"
+)
+
+func (s *noteServer) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.CreateCategoryResponse, error) {
+	name, err := categoryName(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	category := &model.Category{
+		OwnerID:  auth.OwnerID(ctx),
+		ParentID: optionalID(req.GetParentId()),
+		Name:     name,
+	}
+	if err := s.db.CreateCategory(nil, category); err != nil {
+		return nil, dbError(err, "failed to create category")
+	}
+
+	return &pb.CreateCategoryResponse{
+		Category: toProtoCategory(category),
+	}, nil
+}
@@ -2,35 +2,46 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"note/auth"
 	"note/db/model"
 	pb "note/grpc"
 
-	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 func (s *noteServer) CreateNote(ctx context.Context, req *pb.CreateNoteRequest) (*pb.CreateNoteResponse, error) {
+	tags, err := normalizeTags(req.GetTags())
+	if err != nil {
+		return nil, err
+	}
+
 	note := &model.Note{
-		Title:    req.GetTitle(),
-		Content:  req.GetContent(),
-		Category: req.GetCategory(),
-		Tags:     req.GetTags(),
+		OwnerID:    auth.OwnerID(ctx),
+		Title:      req.GetTitle(),
+		Content:    req.GetContent(),
+		Tags:       tags,
+		CategoryID: optionalID(req.GetCategoryId()),
 	}
 
-	err := s.db.CreateNote(nil, note)
+	err = s.db.StartTransaction(func(tx *gorm.DB) error {
+		// カテゴリは作成者のものだけを指定できる。category はそのカテゴリの名前にそろえる
+		if note.CategoryID != nil {
+			category, err := s.db.GetCategory(tx, note.OwnerID, *note.CategoryID)
+			if err != nil {
+				return err
+			}
+			note.Category = category.Name
+		}
+		if err := s.db.CreateNote(tx, note); err != nil {
+			return err
+		}
+		return s.recordRevision(tx, note, model.RevisionCreate, note.OwnerID)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create note: %w", err)
+		return nil, dbError(err, "failed to create note")
 	}
 
 	return &pb.CreateNoteResponse{
-		Note: &pb.Note{
-			Id:        note.ID,
-			Title:     note.Title,
-			Content:   note.Content,
-			Category:  note.Category,
-			Tags:      note.Tags,
-			CreatedAt: timestamppb.New(note.CreatedAt),
-			UpdatedAt: timestamppb.New(note.UpdatedAt),
-		},
+		Note: toProtoNote(note),
 	}, nil
 }
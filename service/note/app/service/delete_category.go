@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+	"note/auth"
+	pb "note/grpc"
+
+	"gorm.io/gorm"
+)
+
+func (s *noteServer) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest) (*pb.DeleteCategoryResponse, error) {
+	ownerID := auth.OwnerID(ctx)
+
+	err := s.db.StartTransaction(func(tx *gorm.DB) error {
+		return s.db.DeleteCategory(tx, ownerID, req.GetId(), optionalID(req.GetReassignToId()))
+	})
+	if err != nil {
+		return nil, dbError(err, "failed to delete category")
+	}
+
+	return &pb.DeleteCategoryResponse{
+		Success: true,
+	}, nil
+}
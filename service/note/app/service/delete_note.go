@@ -2,21 +2,31 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"note/auth"
+	"note/db/model"
 	pb "note/grpc"
+
+	"gorm.io/gorm"
 )
 
+// DeleteNote はノートをゴミ箱に移動する。RestoreNote で元に戻せる。
+// 共有されたユーザーは削除できない
 func (s *noteServer) DeleteNote(ctx context.Context, req *pb.DeleteNoteRequest) (*pb.DeleteNoteResponse, error) {
-	// まず該当のノートが存在するか確認
-	_, err := s.db.GetNoteByID(nil, req.GetId())
-	if err != nil {
-		return nil, fmt.Errorf("failed to find note for deletion: %w", err)
-	}
+	ownerID := auth.OwnerID(ctx)
 
-	// ノートを削除
-	err = s.db.DeleteNote(nil, req.GetId())
+	err := s.db.StartTransaction(func(tx *gorm.DB) error {
+		note, err := s.authorizeNote(tx, ownerID, req.GetId(), model.RoleOwner)
+		if err != nil {
+			return err
+		}
+		if err := s.db.DeleteNote(tx, ownerID, req.GetId(), req.GetExpectedVersion()); err != nil {
+			return err
+		}
+		// 削除してもノートの内容は変わらないので、削除前の状態を記録する
+		return s.recordRevision(tx, note, model.RevisionDelete, ownerID)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete note: %w", err)
+		return nil, dbError(err, "failed to delete note")
 	}
 
 	return &pb.DeleteNoteResponse{
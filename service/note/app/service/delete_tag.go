@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+	"note/auth"
+	pb "note/grpc"
+)
+
+// DeleteTag はタグをすべてのノートから外す
+func (s *noteServer) DeleteTag(ctx context.Context, req *pb.DeleteTagRequest) (*pb.DeleteTagResponse, error) {
+	tags, err := normalizeTags([]string{req.GetTag()})
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := s.rewriteTags(auth.OwnerID(ctx), tags, "")
+	if err != nil {
+		return nil, dbError(err, "failed to delete tag")
+	}
+
+	return &pb.DeleteTagResponse{
+		AffectedNotes: int32(affected),
+	}, nil
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"note/auth"
+	"note/db/model"
+	"note/diff"
+	pb "note/grpc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DiffNoteRevisions は from_revision_id から to_revision_id への本文の差分を返す。
+// to_revision_id を省略すると現在のノートと比較する
+func (s *noteServer) DiffNoteRevisions(ctx context.Context, req *pb.DiffNoteRevisionsRequest) (*pb.DiffNoteRevisionsResponse, error) {
+	note, err := s.authorizeNote(nil, auth.OwnerID(ctx), req.GetNoteId(), model.RoleViewer)
+	if err != nil {
+		return nil, dbError(err, "failed to diff note revisions")
+	}
+
+	from, err := s.db.GetNoteRevision(nil, req.GetNoteId(), req.GetFromRevisionId())
+	if err != nil {
+		return nil, dbError(err, "failed to diff note revisions")
+	}
+
+	toContent := note.Content
+	if req.GetToRevisionId() != "" {
+		to, err := s.db.GetNoteRevision(nil, req.GetNoteId(), req.GetToRevisionId())
+		if err != nil {
+			return nil, dbError(err, "failed to diff note revisions")
+		}
+		toContent = to.Content
+	}
+
+	lines, err := diff.Lines(from.Content, toContent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to diff note revisions: %v", err)
+	}
+
+	return &pb.DiffNoteRevisionsResponse{
+		Lines: toProtoDiffLines(lines),
+	}, nil
+}
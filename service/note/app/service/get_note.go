@@ -2,27 +2,19 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"note/auth"
+	"note/db/model"
 	pb "note/grpc"
-
-	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// GetNote はノートを返す。共有されたノートはどの権限でも読める
 func (s *noteServer) GetNote(ctx context.Context, req *pb.GetNoteRequest) (*pb.GetNoteResponse, error) {
-	note, err := s.db.GetNoteByID(nil, req.GetId())
+	note, err := s.authorizeNote(nil, auth.OwnerID(ctx), req.GetId(), model.RoleViewer)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get note: %w", err)
+		return nil, dbError(err, "failed to get note")
 	}
 
 	return &pb.GetNoteResponse{
-		Note: &pb.Note{
-			Id:        note.ID,
-			Title:     note.Title,
-			Content:   note.Content,
-			Category:  note.Category,
-			Tags:      note.Tags,
-			CreatedAt: timestamppb.New(note.CreatedAt),
-			UpdatedAt: timestamppb.New(note.UpdatedAt),
-		},
+		Note: toProtoNote(note),
 	}, nil
 }
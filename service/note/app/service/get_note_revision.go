@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+	"note/auth"
+	"note/db/model"
+	pb "note/grpc"
+)
+
+func (s *noteServer) GetNoteRevision(ctx context.Context, req *pb.GetNoteRevisionRequest) (*pb.GetNoteRevisionResponse, error) {
+	if _, err := s.authorizeNote(nil, auth.OwnerID(ctx), req.GetNoteId(), model.RoleViewer); err != nil {
+		return nil, dbError(err, "failed to get note revision")
+	}
+
+	revision, err := s.db.GetNoteRevision(nil, req.GetNoteId(), req.GetRevisionId())
+	if err != nil {
+		return nil, dbError(err, "failed to get note revision")
+	}
+
+	return &pb.GetNoteRevisionResponse{
+		Revision: toProtoNoteRevision(revision),
+	}, nil
+}
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"note/auth"
+	pb "note/grpc"
+)
+
+// ListCategories はユーザーのカテゴリを木構造で返す
+func (s *noteServer) ListCategories(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.ListCategoriesResponse, error) {
+	categories, err := s.db.ListCategories(nil, auth.OwnerID(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	return &pb.ListCategoriesResponse{
+		Roots: categoryTree(categories),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+	"note/auth"
+	pb "note/grpc"
+)
+
+func (s *noteServer) ListDeletedNotes(ctx context.Context, req *pb.ListDeletedNotesRequest) (*pb.ListDeletedNotesResponse, error) {
+	notes, totalCount, err := s.db.ListDeletedNotes(nil, auth.OwnerID(ctx), req.GetPage(), req.GetLimit())
+	if err != nil {
+		return nil, dbError(err, "failed to list deleted notes")
+	}
+
+	grpcNotes := make([]*pb.Note, len(notes))
+	for i, note := range notes {
+		grpcNotes[i] = toProtoNote(note)
+	}
+
+	return &pb.ListDeletedNotesResponse{
+		Notes:      grpcNotes,
+		TotalCount: int32(totalCount),
+	}, nil
+}
@@ -3,11 +3,14 @@ package service
 import (
 	"context"
 	"fmt"
+	"note/auth"
+	"note/db"
+	"note/db/model"
 	pb "note/grpc"
-
-	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// ListNotes はノートの一覧を返す。page_token を指定するとキーセットで続きを取得し、
+// 指定しない場合は従来どおり page のオフセットで取得する
 func (s *noteServer) ListNotes(ctx context.Context, req *pb.ListNotesRequest) (*pb.ListNotesResponse, error) {
 	// デフォルト値の設定
 	page := req.GetPage()
@@ -18,29 +21,81 @@ func (s *noteServer) ListNotes(ctx context.Context, req *pb.ListNotesRequest) (*
 	if limit <= 0 {
 		limit = 10
 	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	order, err := parseOrderBy(req.GetOrderBy())
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := tagFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var after *model.Note
+	if req.GetPageToken() != "" {
+		after, err = decodePageToken(order, req.GetPageToken())
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	// データベースからノートを取得
-	notes, totalCount, err := s.db.ListNotes(nil, page, limit, req.GetCategory(), req.GetTags())
+	// 次のページがあるかを知るために1件多く取得する
+	notes, totalCount, err := s.db.ListNotes(nil, db.ListNotesParams{
+		OwnerID:       auth.OwnerID(ctx),
+		IncludeShared: req.GetIncludeShared(),
+		Offset:        (page - 1) * limit,
+		Limit:         limit + 1,
+		Category:      req.GetCategory(),
+		CategoryID:    req.GetCategoryId(),
+		Tags:          tags,
+		Order:         order,
+		After:         after,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list notes: %w", err)
 	}
 
+	var nextPageToken string
+	if len(notes) > int(limit) {
+		notes = notes[:limit]
+		nextPageToken = encodePageToken(order, notes[len(notes)-1])
+	}
+
 	// gRPCレスポンス用に変換
 	grpcNotes := make([]*pb.Note, len(notes))
 	for i, note := range notes {
-		grpcNotes[i] = &pb.Note{
-			Id:        note.ID,
-			Title:     note.Title,
-			Content:   note.Content,
-			Category:  note.Category,
-			Tags:      note.Tags,
-			CreatedAt: timestamppb.New(note.CreatedAt),
-			UpdatedAt: timestamppb.New(note.UpdatedAt),
-		}
+		grpcNotes[i] = toProtoNote(note)
 	}
 
 	return &pb.ListNotesResponse{
-		Notes:      grpcNotes,
-		TotalCount: int32(totalCount),
+		Notes:         grpcNotes,
+		TotalCount:    int32(totalCount),
+		NextPageToken: nextPageToken,
 	}, nil
 }
+
+// tagFilter はリクエストのタグの条件を、保存されたタグと同じ規則で正規化して返す。
+// tags は tag_filter.all と同じく扱う
+func tagFilter(req *pb.ListNotesRequest) (db.TagFilter, error) {
+	all := make([]string, 0, len(req.GetTags())+len(req.GetTagFilter().GetAll()))
+	all = append(all, req.GetTags()...)
+	all = append(all, req.GetTagFilter().GetAll()...)
+
+	var filter db.TagFilter
+	var err error
+	if filter.All, err = normalizeTags(all); err != nil {
+		return db.TagFilter{}, err
+	}
+	if filter.Any, err = normalizeTags(req.GetTagFilter().GetAny()); err != nil {
+		return db.TagFilter{}, err
+	}
+	if filter.None, err = normalizeTags(req.GetTagFilter().GetNone()); err != nil {
+		return db.TagFilter{}, err
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"note/auth"
+	"note/db/model"
+	pb "note/grpc"
+)
+
+// ListNoteRevisions はノートのリビジョンを新しい順に返す
+func (s *noteServer) ListNoteRevisions(ctx context.Context, req *pb.ListNoteRevisionsRequest) (*pb.ListNoteRevisionsResponse, error) {
+	if _, err := s.authorizeNote(nil, auth.OwnerID(ctx), req.GetNoteId(), model.RoleViewer); err != nil {
+		return nil, dbError(err, "failed to list note revisions")
+	}
+
+	revisions, totalCount, err := s.db.ListNoteRevisions(nil, req.GetNoteId(), req.GetPage(), req.GetLimit())
+	if err != nil {
+		return nil, dbError(err, "failed to list note revisions")
+	}
+
+	protoRevisions := make([]*pb.NoteRevision, len(revisions))
+	for i, revision := range revisions {
+		protoRevisions[i] = toProtoNoteRevision(revision)
+	}
+
+	return &pb.ListNoteRevisionsResponse{
+		Revisions:  protoRevisions,
+		TotalCount: int32(totalCount),
+	}, nil
+}
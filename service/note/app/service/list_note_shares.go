@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+	"note/auth"
+	"note/db/model"
+	pb "note/grpc"
+)
+
+// ListNoteShares はノートの共有先を返す
+func (s *noteServer) ListNoteShares(ctx context.Context, req *pb.ListNoteSharesRequest) (*pb.ListNoteSharesResponse, error) {
+	if _, err := s.authorizeNote(nil, auth.OwnerID(ctx), req.GetNoteId(), model.RoleOwner); err != nil {
+		return nil, dbError(err, "failed to list note shares")
+	}
+
+	shares, err := s.db.ListNoteShares(nil, req.GetNoteId())
+	if err != nil {
+		return nil, dbError(err, "failed to list note shares")
+	}
+
+	protoShares := make([]*pb.NoteShare, len(shares))
+	for i, share := range shares {
+		protoShares[i] = toProtoNoteShare(share)
+	}
+
+	return &pb.ListNoteSharesResponse{
+		Shares: protoShares,
+	}, nil
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"note/auth"
+	pb "note/grpc"
+)
+
+// ListSharedWithMe は呼び出したユーザーに共有されたノートを更新日時の新しい順に返す
+func (s *noteServer) ListSharedWithMe(ctx context.Context, req *pb.ListSharedWithMeRequest) (*pb.ListSharedWithMeResponse, error) {
+	notes, totalCount, err := s.db.ListSharedWithMe(nil, auth.OwnerID(ctx), req.GetPage(), req.GetLimit())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shared notes: %w", err)
+	}
+
+	protoNotes := make([]*pb.SharedNote, len(notes))
+	for i, note := range notes {
+		protoNotes[i] = &pb.SharedNote{
+			Note: toProtoNote(&note.Note),
+			Role: toProtoShareRole(note.Role),
+		}
+	}
+
+	return &pb.ListSharedWithMeResponse{
+		Notes:      protoNotes,
+		TotalCount: int32(totalCount),
+	}, nil
+}
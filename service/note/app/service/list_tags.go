@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"note/auth"
+	pb "note/grpc"
+	"strings"
+)
+
+// ListTags はタグとノートの数を返す。prefix で前方一致の補完候補を取得できる
+func (s *noteServer) ListTags(ctx context.Context, req *pb.ListTagsRequest) (*pb.ListTagsResponse, error) {
+	limit := req.GetLimit()
+	if limit <= 0 || limit > maxTagsPageSize {
+		limit = maxTagsPageSize
+	}
+
+	// 保存されたタグと同じく小文字で比較する
+	prefix := strings.ToLower(strings.TrimSpace(req.GetPrefix()))
+
+	tags, err := s.db.ListTags(nil, auth.OwnerID(ctx), prefix, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	protoTags := make([]*pb.TagCount, len(tags))
+	for i, t := range tags {
+		protoTags[i] = &pb.TagCount{
+			Name:      t.Name,
+			NoteCount: t.NoteCount,
+		}
+	}
+
+	return &pb.ListTagsResponse{
+		Tags: protoTags,
+	}, nil
+}
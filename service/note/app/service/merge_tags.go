@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"note/auth"
+	pb "note/grpc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MergeTags は source_tags のタグをすべて target_tag にまとめる
+func (s *noteServer) MergeTags(ctx context.Context, req *pb.MergeTagsRequest) (*pb.MergeTagsResponse, error) {
+	if len(req.GetSourceTags()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "source_tags is required")
+	}
+
+	sources, err := normalizeTags(req.GetSourceTags())
+	if err != nil {
+		return nil, err
+	}
+	targets, err := normalizeTags([]string{req.GetTargetTag()})
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := s.rewriteTags(auth.OwnerID(ctx), sources, targets[0])
+	if err != nil {
+		return nil, dbError(err, "failed to merge tags")
+	}
+
+	return &pb.MergeTagsResponse{
+		AffectedNotes: int32(affected),
+	}, nil
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+	"note/auth"
+	"note/db/model"
+	pb "note/grpc"
+
+	"gorm.io/gorm"
+)
+
+// MoveCategory はカテゴリを parent_id の下に移動する。子孫のカテゴリも一緒に移動する
+func (s *noteServer) MoveCategory(ctx context.Context, req *pb.MoveCategoryRequest) (*pb.MoveCategoryResponse, error) {
+	ownerID := auth.OwnerID(ctx)
+
+	var category *model.Category
+	err := s.db.StartTransaction(func(tx *gorm.DB) error {
+		if err := s.db.MoveCategory(tx, ownerID, req.GetId(), optionalID(req.GetParentId())); err != nil {
+			return err
+		}
+
+		var err error
+		category, err = s.db.GetCategory(tx, ownerID, req.GetId())
+		return err
+	})
+	if err != nil {
+		return nil, dbError(err, "failed to move category")
+	}
+
+	return &pb.MoveCategoryResponse{
+		Category: toProtoCategory(category),
+	}, nil
+}
@@ -2,8 +2,15 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"note/db"
+	"note/db/model"
 	pb "note/grpc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type NoteService interface {
@@ -12,6 +19,27 @@ type NoteService interface {
 	DeleteNote(ctx context.Context, req *pb.DeleteNoteRequest) (*pb.DeleteNoteResponse, error)
 	GetNote(ctx context.Context, req *pb.GetNoteRequest) (*pb.GetNoteResponse, error)
 	ListNotes(ctx context.Context, req *pb.ListNotesRequest) (*pb.ListNotesResponse, error)
+	SearchNotes(ctx context.Context, req *pb.SearchNotesRequest) (*pb.SearchNotesResponse, error)
+	ListDeletedNotes(ctx context.Context, req *pb.ListDeletedNotesRequest) (*pb.ListDeletedNotesResponse, error)
+	RestoreNote(ctx context.Context, req *pb.RestoreNoteRequest) (*pb.RestoreNoteResponse, error)
+	PurgeNote(ctx context.Context, req *pb.PurgeNoteRequest) (*pb.PurgeNoteResponse, error)
+	ShareNote(ctx context.Context, req *pb.ShareNoteRequest) (*pb.ShareNoteResponse, error)
+	UnshareNote(ctx context.Context, req *pb.UnshareNoteRequest) (*pb.UnshareNoteResponse, error)
+	ListNoteShares(ctx context.Context, req *pb.ListNoteSharesRequest) (*pb.ListNoteSharesResponse, error)
+	ListSharedWithMe(ctx context.Context, req *pb.ListSharedWithMeRequest) (*pb.ListSharedWithMeResponse, error)
+	ListNoteRevisions(ctx context.Context, req *pb.ListNoteRevisionsRequest) (*pb.ListNoteRevisionsResponse, error)
+	GetNoteRevision(ctx context.Context, req *pb.GetNoteRevisionRequest) (*pb.GetNoteRevisionResponse, error)
+	DiffNoteRevisions(ctx context.Context, req *pb.DiffNoteRevisionsRequest) (*pb.DiffNoteRevisionsResponse, error)
+	RestoreNoteRevision(ctx context.Context, req *pb.RestoreNoteRevisionRequest) (*pb.RestoreNoteRevisionResponse, error)
+	CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.CreateCategoryResponse, error)
+	ListCategories(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.ListCategoriesResponse, error)
+	RenameCategory(ctx context.Context, req *pb.RenameCategoryRequest) (*pb.RenameCategoryResponse, error)
+	MoveCategory(ctx context.Context, req *pb.MoveCategoryRequest) (*pb.MoveCategoryResponse, error)
+	DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest) (*pb.DeleteCategoryResponse, error)
+	ListTags(ctx context.Context, req *pb.ListTagsRequest) (*pb.ListTagsResponse, error)
+	RenameTag(ctx context.Context, req *pb.RenameTagRequest) (*pb.RenameTagResponse, error)
+	MergeTags(ctx context.Context, req *pb.MergeTagsRequest) (*pb.MergeTagsResponse, error)
+	DeleteTag(ctx context.Context, req *pb.DeleteTagRequest) (*pb.DeleteTagResponse, error)
 }
 
 type noteServer struct {
@@ -23,3 +51,41 @@ type noteServer struct {
 func NewNoteServer(db db.DB) pb.NoteServiceServer {
 	return &noteServer{db: db}
 }
+
+// toProtoNote はモデルのノートを gRPC のノートに変換する
+func toProtoNote(note *model.Note) *pb.Note {
+	protoNote := &pb.Note{
+		Id:         note.ID,
+		Title:      note.Title,
+		Content:    note.Content,
+		Category:   note.Category,
+		Tags:       note.Tags,
+		CreatedAt:  timestamppb.New(note.CreatedAt),
+		UpdatedAt:  timestamppb.New(note.UpdatedAt),
+		Version:    note.Version,
+		OwnerId:    note.OwnerID,
+		CategoryId: stringValue(note.CategoryID),
+	}
+	if note.DeletedAt.Valid {
+		protoNote.DeletedAt = timestamppb.New(note.DeletedAt.Time)
+	}
+	return protoNote
+}
+
+// dbError は db のエラーを gRPC のステータスエラーに変換する
+func dbError(err error, msg string) error {
+	switch {
+	case errors.Is(err, db.ErrNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, db.ErrVersionMismatch):
+		return status.Errorf(codes.Aborted, "%s: %v", msg, err)
+	case errors.Is(err, errPermissionDenied):
+		return status.Errorf(codes.PermissionDenied, "%s: %v", msg, err)
+	case errors.Is(err, db.ErrAlreadyExists):
+		return status.Errorf(codes.AlreadyExists, "%s: %v", msg, err)
+	case errors.Is(err, db.ErrCategoryCycle):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	default:
+		return fmt.Errorf("%s: %w", msg, err)
+	}
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"note/db"
+	"note/db/model"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxPageSize は1ページで返すノートの上限
+const maxPageSize = 100
+
+// pageToken は次のページの開始位置。クライアントには不透明な文字列として渡す
+type pageToken struct {
+	// OrderBy はトークンを作ったときの並び順。別の並び順では使えない
+	OrderBy string `json:"o"`
+	// ID と Value は前のページの最後のノートの ID と並び替えカラムの値
+	ID    string `json:"i"`
+	Value string `json:"v"`
+}
+
+// parseOrderBy は "title asc" や "updated_at desc" の形式の並び順を解析する。
+// 方向を省略すると昇順、order_by 自体を省略すると作成日時の新しい順になる
+func parseOrderBy(orderBy string) (db.NoteOrder, error) {
+	fields := strings.Fields(strings.ToLower(orderBy))
+	if len(fields) == 0 {
+		return db.DefaultNoteOrder, nil
+	}
+	if len(fields) > 2 || !db.ValidNoteOrderField(fields[0]) {
+		return db.NoteOrder{}, status.Errorf(codes.InvalidArgument, "invalid order_by %q: use created_at, updated_at or title followed by asc or desc", orderBy)
+	}
+
+	order := db.NoteOrder{Field: fields[0]}
+	if len(fields) == 2 {
+		switch fields[1] {
+		case "asc":
+		case "desc":
+			order.Desc = true
+		default:
+			return db.NoteOrder{}, status.Errorf(codes.InvalidArgument, "invalid order_by direction %q: use asc or desc", fields[1])
+		}
+	}
+	return order, nil
+}
+
+// orderByString は並び順を order_by の正規形にする
+func orderByString(order db.NoteOrder) string {
+	if order.Desc {
+		return order.Field + " desc"
+	}
+	return order.Field + " asc"
+}
+
+// encodePageToken は last の続きを指すページトークンを作る
+func encodePageToken(order db.NoteOrder, last *model.Note) string {
+	token := pageToken{OrderBy: orderByString(order), ID: last.ID}
+	switch order.Field {
+	case "created_at":
+		token.Value = last.CreatedAt.Format(time.RFC3339Nano)
+	case "updated_at":
+		token.Value = last.UpdatedAt.Format(time.RFC3339Nano)
+	case "title":
+		token.Value = last.Title
+	}
+
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageToken はページトークンを前のページの最後のノートに戻す
+func decodePageToken(order db.NoteOrder, s string) (*model.Note, error) {
+	invalid := func(err error) error {
+		return status.Errorf(codes.InvalidArgument, "invalid page_token: %v", err)
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, invalid(err)
+	}
+	var token pageToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, invalid(err)
+	}
+	if token.OrderBy != orderByString(order) {
+		return nil, invalid(fmt.Errorf("it was created for order_by %q", token.OrderBy))
+	}
+
+	last := &model.Note{ID: token.ID}
+	switch order.Field {
+	case "created_at", "updated_at":
+		t, err := time.Parse(time.RFC3339Nano, token.Value)
+		if err != nil {
+			return nil, invalid(err)
+		}
+		last.CreatedAt, last.UpdatedAt = t, t
+	case "title":
+		last.Title = token.Value
+	}
+	return last, nil
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+	"note/auth"
+	pb "note/grpc"
+)
+
+// PurgeNote はゴミ箱のノートを完全に削除する。ゴミ箱にないノートは対象外
+func (s *noteServer) PurgeNote(ctx context.Context, req *pb.PurgeNoteRequest) (*pb.PurgeNoteResponse, error) {
+	err := s.db.PurgeNote(nil, auth.OwnerID(ctx), req.GetId())
+	if err != nil {
+		return nil, dbError(err, "failed to purge note")
+	}
+
+	return &pb.PurgeNoteResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"note/auth"
+	"note/db/model"
+	pb "note/grpc"
+
+	"gorm.io/gorm"
+)
+
+func (s *noteServer) RenameCategory(ctx context.Context, req *pb.RenameCategoryRequest) (*pb.RenameCategoryResponse, error) {
+	name, err := categoryName(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	ownerID := auth.OwnerID(ctx)
+
+	var category *model.Category
+	err = s.db.StartTransaction(func(tx *gorm.DB) error {
+		if err := s.db.RenameCategory(tx, ownerID, req.GetId(), name); err != nil {
+			return err
+		}
+
+		var err error
+		category, err = s.db.GetCategory(tx, ownerID, req.GetId())
+		return err
+	})
+	if err != nil {
+		return nil, dbError(err, "failed to rename category")
+	}
+
+	return &pb.RenameCategoryResponse{
+		Category: toProtoCategory(category),
+	}, nil
+}
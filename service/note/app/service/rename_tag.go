@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"note/auth"
+	pb "note/grpc"
+)
+
+// RenameTag はタグの名前を変更する。new_tag がすでにあるノートでは1つにまとめる
+func (s *noteServer) RenameTag(ctx context.Context, req *pb.RenameTagRequest) (*pb.RenameTagResponse, error) {
+	tags, err := normalizeTags([]string{req.GetTag(), req.GetNewTag()})
+	if err != nil {
+		return nil, err
+	}
+	// 正規化して同じになる名前への変更は何もしない
+	if len(tags) == 1 {
+		return &pb.RenameTagResponse{}, nil
+	}
+
+	affected, err := s.rewriteTags(auth.OwnerID(ctx), tags[:1], tags[1])
+	if err != nil {
+		return nil, dbError(err, "failed to rename tag")
+	}
+
+	return &pb.RenameTagResponse{
+		AffectedNotes: int32(affected),
+	}, nil
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"note/auth"
+	"note/db/model"
+	pb "note/grpc"
+
+	"gorm.io/gorm"
+)
+
+func (s *noteServer) RestoreNote(ctx context.Context, req *pb.RestoreNoteRequest) (*pb.RestoreNoteResponse, error) {
+	ownerID := auth.OwnerID(ctx)
+
+	var note *model.Note
+	err := s.db.StartTransaction(func(tx *gorm.DB) error {
+		if err := s.db.RestoreNote(tx, ownerID, req.GetId()); err != nil {
+			return err
+		}
+
+		var err error
+		note, err = s.db.GetNoteByID(tx, ownerID, req.GetId())
+		return err
+	})
+	if err != nil {
+		return nil, dbError(err, "failed to restore note")
+	}
+
+	return &pb.RestoreNoteResponse{
+		Note: toProtoNote(note),
+	}, nil
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"note/auth"
+	"note/db"
+	"note/db/model"
+	pb "note/grpc"
+	"note/tag"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// RestoreNoteRevision はノートのタイトル・本文・カテゴリ・タグをリビジョンの内容に戻す。
+// 復元も新しいリビジョンとして記録されるので、復元前の状態にも戻せる
+func (s *noteServer) RestoreNoteRevision(ctx context.Context, req *pb.RestoreNoteRevisionRequest) (*pb.RestoreNoteRevisionResponse, error) {
+	userID := auth.OwnerID(ctx)
+
+	var refreshedNote *model.Note
+	err := s.db.StartTransaction(func(tx *gorm.DB) error {
+		note, err := s.authorizeNote(tx, userID, req.GetNoteId(), model.RoleEditor)
+		if err != nil {
+			return err
+		}
+
+		revision, err := s.db.GetNoteRevision(tx, req.GetNoteId(), req.GetRevisionId())
+		if err != nil {
+			return err
+		}
+
+		// 正規化の導入前に記録したタグも、いまの規則にそろえて書き込む。
+		// 長すぎるタグはノートの移行と同じく切り詰め、復元できなくならないようにする
+		tags := tag.Truncate(revision.Tags)
+
+		fields := map[string]any{
+			"title":       revision.Title,
+			"content":     revision.Content,
+			"category":    "",
+			"tags":        pq.StringArray(tags),
+			"category_id": revision.CategoryID,
+		}
+		// 記録した後に削除されたカテゴリには戻さず、未分類にする。
+		// category は記録時ではなく、いまのカテゴリの名前にそろえる
+		if revision.CategoryID != nil {
+			category, err := s.db.GetCategory(tx, note.OwnerID, *revision.CategoryID)
+			switch {
+			case errors.Is(err, db.ErrNotFound):
+				fields["category_id"] = nil
+			case err != nil:
+				return err
+			default:
+				fields["category"] = category.Name
+			}
+		}
+		if err := s.db.UpdateNoteFields(tx, note.OwnerID, req.GetNoteId(), fields, req.GetExpectedVersion()); err != nil {
+			return err
+		}
+
+		refreshedNote, err = s.db.GetNoteByID(tx, note.OwnerID, req.GetNoteId())
+		if err != nil {
+			return err
+		}
+		return s.recordRevision(tx, refreshedNote, model.RevisionRestore, userID)
+	})
+	if err != nil {
+		return nil, dbError(err, "failed to restore note revision")
+	}
+
+	return &pb.RestoreNoteRevisionResponse{
+		Note: toProtoNote(refreshedNote),
+	}, nil
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"note/db/model"
+	"note/diff"
+	pb "note/grpc"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// recordRevision はノートの現在の状態をリビジョンとして記録する。
+// 操作と同じトランザクションで呼び出す
+func (s *noteServer) recordRevision(tx *gorm.DB, note *model.Note, action model.RevisionAction, editorID string) error {
+	return s.db.CreateNoteRevision(tx, model.NewNoteRevision(note, action, editorID))
+}
+
+// toProtoRevisionAction はモデルの操作を gRPC の操作に変換する
+func toProtoRevisionAction(action model.RevisionAction) pb.RevisionAction {
+	switch action {
+	case model.RevisionCreate:
+		return pb.RevisionAction_REVISION_ACTION_CREATE
+	case model.RevisionUpdate:
+		return pb.RevisionAction_REVISION_ACTION_UPDATE
+	case model.RevisionDelete:
+		return pb.RevisionAction_REVISION_ACTION_DELETE
+	case model.RevisionRestore:
+		return pb.RevisionAction_REVISION_ACTION_RESTORE
+	default:
+		return pb.RevisionAction_REVISION_ACTION_UNSPECIFIED
+	}
+}
+
+// toProtoNoteRevision はモデルのリビジョンを gRPC のリビジョンに変換する
+func toProtoNoteRevision(revision *model.NoteRevision) *pb.NoteRevision {
+	return &pb.NoteRevision{
+		Id:         revision.ID,
+		NoteId:     revision.NoteID,
+		Version:    revision.Version,
+		Action:     toProtoRevisionAction(revision.Action),
+		EditorId:   revision.EditorID,
+		Title:      revision.Title,
+		Content:    revision.Content,
+		Category:   revision.Category,
+		Tags:       revision.Tags,
+		CreatedAt:  timestamppb.New(revision.CreatedAt),
+		CategoryId: stringValue(revision.CategoryID),
+	}
+}
+
+// toProtoDiffLines は行単位の差分を gRPC の差分に変換する
+func toProtoDiffLines(lines []diff.Line) []*pb.DiffLine {
+	protoLines := make([]*pb.DiffLine, len(lines))
+	for i, line := range lines {
+		op := pb.DiffOp_DIFF_OP_EQUAL
+		switch line.Op {
+		case diff.Insert:
+			op = pb.DiffOp_DIFF_OP_INSERT
+		case diff.Delete:
+			op = pb.DiffOp_DIFF_OP_DELETE
+		}
+		protoLines[i] = &pb.DiffLine{Op: op, Text: line.Text}
+	}
+	return protoLines
+}
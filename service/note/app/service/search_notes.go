@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"note/auth"
+	pb "note/grpc"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *noteServer) SearchNotes(ctx context.Context, req *pb.SearchNotesRequest) (*pb.SearchNotesResponse, error) {
+	query := strings.TrimSpace(req.GetQuery())
+	if query == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+
+	// デフォルト値の設定
+	page := req.GetPage()
+	if page <= 0 {
+		page = 1
+	}
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	// 保存されたタグと同じ規則で正規化して比較する
+	tags, err := normalizeTags(req.GetTags())
+	if err != nil {
+		return nil, err
+	}
+
+	results, totalCount, err := s.db.SearchNotes(nil, auth.OwnerID(ctx), query, page, limit, req.GetCategory(), req.GetCategoryId(), tags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search notes: %w", err)
+	}
+
+	// gRPCレスポンス用に変換
+	grpcResults := make([]*pb.SearchResult, len(results))
+	for i, result := range results {
+		grpcResults[i] = &pb.SearchResult{
+			Note:     toProtoNote(&result.Note),
+			Rank:     result.Rank,
+			Headline: result.Headline,
+		}
+	}
+
+	return &pb.SearchNotesResponse{
+		Results:    grpcResults,
+		TotalCount: int32(totalCount),
+	}, nil
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"note/db/model"
+	pb "note/grpc"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// errPermissionDenied は共有された権限では許可されない操作の場合に返される
+var errPermissionDenied = errors.New("permission denied")
+
+// authorizeNote は userID が required 以上の権限を持つノートを返す。
+// 見えないノートは ErrNotFound、権限が足りなければ errPermissionDenied になる
+func (s *noteServer) authorizeNote(tx *gorm.DB, userID, id string, required model.ShareRole) (*model.Note, error) {
+	note, role, err := s.db.GetAccessibleNote(tx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !role.Allows(required) {
+		return nil, fmt.Errorf("note with ID %s requires %s role, have %s: %w", id, required, role, errPermissionDenied)
+	}
+	return note, nil
+}
+
+// shareRoleFromProto は gRPC の権限をモデルの権限に変換する。不正な値は空文字になる
+func shareRoleFromProto(role pb.ShareRole) model.ShareRole {
+	switch role {
+	case pb.ShareRole_SHARE_ROLE_VIEWER:
+		return model.RoleViewer
+	case pb.ShareRole_SHARE_ROLE_COMMENTER:
+		return model.RoleCommenter
+	case pb.ShareRole_SHARE_ROLE_EDITOR:
+		return model.RoleEditor
+	default:
+		return ""
+	}
+}
+
+// toProtoShareRole はモデルの権限を gRPC の権限に変換する
+func toProtoShareRole(role model.ShareRole) pb.ShareRole {
+	switch role {
+	case model.RoleViewer:
+		return pb.ShareRole_SHARE_ROLE_VIEWER
+	case model.RoleCommenter:
+		return pb.ShareRole_SHARE_ROLE_COMMENTER
+	case model.RoleEditor:
+		return pb.ShareRole_SHARE_ROLE_EDITOR
+	default:
+		return pb.ShareRole_SHARE_ROLE_UNSPECIFIED
+	}
+}
+
+// toProtoNoteShare はモデルの共有を gRPC の共有に変換する
+func toProtoNoteShare(share *model.NoteShare) *pb.NoteShare {
+	return &pb.NoteShare{
+		NoteId:    share.NoteID,
+		UserId:    share.UserID,
+		Role:      toProtoShareRole(share.Role),
+		CreatedAt: timestamppb.New(share.CreatedAt),
+		UpdatedAt: timestamppb.New(share.UpdatedAt),
+	}
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"note/auth"
+	"note/db/model"
+	pb "note/grpc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// ShareNote はノートを他のユーザーに共有する。すでに共有している場合は権限を変更する
+func (s *noteServer) ShareNote(ctx context.Context, req *pb.ShareNoteRequest) (*pb.ShareNoteResponse, error) {
+	ownerID := auth.OwnerID(ctx)
+	if ownerID == "" {
+		return nil, status.Error(codes.FailedPrecondition, "sharing notes requires authentication")
+	}
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.GetUserId() == ownerID {
+		return nil, status.Error(codes.InvalidArgument, "cannot share a note with its owner")
+	}
+	role := shareRoleFromProto(req.GetRole())
+	if role == "" {
+		return nil, status.Error(codes.InvalidArgument, "role must be viewer, commenter or editor")
+	}
+
+	share := &model.NoteShare{
+		NoteID: req.GetNoteId(),
+		UserID: req.GetUserId(),
+		Role:   role,
+	}
+	err := s.db.StartTransaction(func(tx *gorm.DB) error {
+		if _, err := s.authorizeNote(tx, ownerID, req.GetNoteId(), model.RoleOwner); err != nil {
+			return err
+		}
+		return s.db.ShareNote(tx, share)
+	})
+	if err != nil {
+		return nil, dbError(err, "failed to share note")
+	}
+
+	return &pb.ShareNoteResponse{
+		Share: toProtoNoteShare(share),
+	}, nil
+}
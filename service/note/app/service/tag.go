@@ -0,0 +1,57 @@
+package service
+
+import (
+	"note/db/model"
+	"note/tag"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// maxTagsPageSize は ListTags で一度に返すタグの上限
+const maxTagsPageSize = 100
+
+// normalizeTags はタグを正規化する。不正なタグは INVALID_ARGUMENT になる
+func normalizeTags(tags []string) ([]string, error) {
+	normalized, err := tag.NormalizeAll(tags)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag: %v", err)
+	}
+	return normalized, nil
+}
+
+// rewriteTags は from のタグが付いたノートのタグを to に置き換え、書き換えたノートの数を返す。
+// to が空ならタグを削除する。書き換えたノートはリビジョンとして記録する
+func (s *noteServer) rewriteTags(ownerID string, from []string, to string) (int, error) {
+	affected := 0
+	err := s.db.StartTransaction(func(tx *gorm.DB) error {
+		notes, err := s.db.FindNotesByTags(tx, ownerID, from)
+		if err != nil {
+			return err
+		}
+
+		for _, note := range notes {
+			tags, changed := tag.Replace(note.Tags, from, to)
+			if !changed {
+				continue
+			}
+			if err := s.db.SetNoteTags(tx, note.ID, tags); err != nil {
+				return err
+			}
+
+			note.Tags = tags
+			note.Version++
+			if err := s.recordRevision(tx, note, model.RevisionUpdate, ownerID); err != nil {
+				return err
+			}
+			affected++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return affected, nil
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"log"
+	"note/db"
+	"time"
+)
+
+// PurgeTrash は interval ごとに retention より前にゴミ箱に移動したノートを完全に削除する。
+// ctx が終了するまで戻らない
+func PurgeTrash(ctx context.Context, database db.DB, retention, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := database.PurgeDeletedNotes(nil, time.Now().Add(-retention))
+		if err != nil {
+			log.Printf("⚠️  Failed to purge trash: %v", err)
+		} else if purged > 0 {
+			log.Printf("🗑️  Purged %d notes from the trash", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+	"note/auth"
+	"note/db/model"
+	pb "note/grpc"
+
+	"gorm.io/gorm"
+)
+
+// UnshareNote はノートの共有を解除する
+func (s *noteServer) UnshareNote(ctx context.Context, req *pb.UnshareNoteRequest) (*pb.UnshareNoteResponse, error) {
+	ownerID := auth.OwnerID(ctx)
+
+	err := s.db.StartTransaction(func(tx *gorm.DB) error {
+		if _, err := s.authorizeNote(tx, ownerID, req.GetNoteId(), model.RoleOwner); err != nil {
+			return err
+		}
+		return s.db.UnshareNote(tx, req.GetNoteId(), req.GetUserId())
+	})
+	if err != nil {
+		return nil, dbError(err, "failed to unshare note")
+	}
+
+	return &pb.UnshareNoteResponse{
+		Success: true,
+	}, nil
+}
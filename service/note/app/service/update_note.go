@@ -2,53 +2,101 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"note/auth"
 	"note/db/model"
 	pb "note/grpc"
 
-	"google.golang.org/protobuf/types/known/timestamppb"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
+// UpdateNote はノートを更新する。update_mask があればそのフィールドだけを更新する。
+// expected_version が現在のバージョンと異なる場合は ABORTED を返す。
+// 共有されたノートは editor の権限で更新できる
 func (s *noteServer) UpdateNote(ctx context.Context, req *pb.UpdateNoteRequest) (*pb.UpdateNoteResponse, error) {
-	// 既存のノートを取得
-	existingNote, err := s.db.GetNoteByID(nil, req.GetId())
+	fields, err := updateFields(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get note for update: %w", err)
+		return nil, err
 	}
 
-	// 更新するフィールドを設定
-	updatedNote := &model.Note{
-		ID:       existingNote.ID,
-		Title:    req.GetTitle(),
-		Content:  req.GetContent(),
-		Category: req.GetCategory(),
-		Tags:     req.GetTags(),
-		// CreatedAtは既存の値を保持
-		CreatedAt: existingNote.CreatedAt,
-		// UpdatedAtは自動更新される
-	}
+	userID := auth.OwnerID(ctx)
 
-	// データベースで更新
-	err = s.db.UpdateNote(nil, updatedNote)
-	if err != nil {
-		return nil, fmt.Errorf("failed to update note: %w", err)
-	}
+	var refreshedNote *model.Note
+	err = s.db.StartTransaction(func(tx *gorm.DB) error {
+		note, err := s.authorizeNote(tx, userID, req.GetId(), model.RoleEditor)
+		if err != nil {
+			return err
+		}
+
+		// 共有されたユーザーも所有者のカテゴリだけを指定できる。
+		// category はそのカテゴリの名前にそろえ、未分類なら空にする
+		if categoryID, ok := fields["category_id"].(*string); ok {
+			fields["category"] = ""
+			if categoryID != nil {
+				category, err := s.db.GetCategory(tx, note.OwnerID, *categoryID)
+				if err != nil {
+					return err
+				}
+				fields["category"] = category.Name
+			}
+		}
+
+		if err := s.db.UpdateNoteFields(tx, note.OwnerID, req.GetId(), fields, req.GetExpectedVersion()); err != nil {
+			return err
+		}
 
-	// 更新後のノートを取得（UpdatedAtを正確に取得するため）
-	refreshedNote, err := s.db.GetNoteByID(nil, req.GetId())
+		// 更新後のノートを取得（UpdatedAtとVersionを正確に取得するため）
+		refreshedNote, err = s.db.GetNoteByID(tx, note.OwnerID, req.GetId())
+		if err != nil {
+			return err
+		}
+		return s.recordRevision(tx, refreshedNote, model.RevisionUpdate, userID)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get updated note: %w", err)
+		return nil, dbError(err, "failed to update note")
 	}
 
 	return &pb.UpdateNoteResponse{
-		Note: &pb.Note{
-			Id:        refreshedNote.ID,
-			Title:     refreshedNote.Title,
-			Content:   refreshedNote.Content,
-			Category:  refreshedNote.Category,
-			Tags:      refreshedNote.Tags,
-			CreatedAt: timestamppb.New(refreshedNote.CreatedAt),
-			UpdatedAt: timestamppb.New(refreshedNote.UpdatedAt),
-		},
+		Note: toProtoNote(refreshedNote),
 	}, nil
 }
+
+// updateFields は update_mask から更新するカラムと値を作る。
+// マスクがない場合は category_id 以外を、"*" の場合は全フィールドを置き換える。
+// category_id を知らない古いクライアントがカテゴリを外してしまわないように、
+// category_id はマスクで指定したときだけ更新する。
+// category は category_id のカテゴリ名から決まるため、リクエストの値は使わない
+func updateFields(req *pb.UpdateNoteRequest) (map[string]any, error) {
+	paths := req.GetUpdateMask().GetPaths()
+	switch {
+	case len(paths) == 0:
+		paths = []string{"title", "content", "tags"}
+	case len(paths) == 1 && paths[0] == "*":
+		paths = []string{"title", "content", "tags", "category_id"}
+	}
+
+	fields := make(map[string]any, len(paths))
+	for _, path := range paths {
+		switch path {
+		case "title":
+			fields["title"] = req.GetTitle()
+		case "content":
+			fields["content"] = req.GetContent()
+		case "category":
+			return nil, status.Error(codes.InvalidArgument, "category is read-only: use category_id")
+		case "tags":
+			tags, err := normalizeTags(req.GetTags())
+			if err != nil {
+				return nil, err
+			}
+			fields["tags"] = pq.StringArray(tags)
+		case "category_id":
+			fields["category_id"] = optionalID(req.GetCategoryId())
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update_mask path %q: use title, content, tags or category_id", path)
+		}
+	}
+	return fields, nil
+}
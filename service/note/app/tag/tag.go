@@ -0,0 +1,89 @@
+// Package tag はノートのタグを正規化する
+package tag
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxLength はタグの最大文字数
+const MaxLength = 50
+
+// ErrEmpty は空白だけのタグの場合に返される
+var ErrEmpty = errors.New("tag is empty")
+
+// Normalize は前後の空白を除き、小文字にしたタグを返す
+func Normalize(tag string) (string, error) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if tag == "" {
+		return "", ErrEmpty
+	}
+	if utf8.RuneCountInString(tag) > MaxLength {
+		return "", fmt.Errorf("tag %q is longer than %d characters", tag, MaxLength)
+	}
+	return tag, nil
+}
+
+// NormalizeAll はタグをすべて正規化し、重複を最初の位置に残して除く
+func NormalizeAll(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		n, err := Normalize(t)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[n] {
+			seen[n] = true
+			normalized = append(normalized, n)
+		}
+	}
+	return normalized, nil
+}
+
+// Truncate は保存済みのタグを NormalizeAll と同じ規則にそろえる。エラーにはせず、
+// MaxLength より長いタグはその文字数に切り詰め、空白だけのタグは除く。
+// データベースの移行で保存済みのノートのタグをそろえるのと同じ結果になる
+func Truncate(tags []string) []string {
+	truncated := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if runes := []rune(t); len(runes) > MaxLength {
+			t = strings.TrimSpace(string(runes[:MaxLength]))
+		}
+		if t == "" || seen[t] {
+			continue
+		}
+		seen[t] = true
+		truncated = append(truncated, t)
+	}
+	return truncated
+}
+
+// Replace は from のタグを to に置き換えたタグと、変更があったかどうかを返す。
+// to が空の場合は from のタグを削除する。置き換えで重複したタグは最初の位置に残す
+func Replace(tags, from []string, to string) ([]string, bool) {
+	replace := make(map[string]bool, len(from))
+	for _, t := range from {
+		replace[t] = true
+	}
+
+	replaced := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	changed := false
+	for _, t := range tags {
+		if replace[t] {
+			changed = true
+			t = to
+		}
+		if t == "" || seen[t] {
+			continue
+		}
+		seen[t] = true
+		replaced = append(replaced, t)
+	}
+	return replaced, changed
+}
@@ -0,0 +1,63 @@
+package tag
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeAll(t *testing.T) {
+	got, err := NormalizeAll([]string{" Go ", "gRPC", "go", "ＧＯ"})
+	if err != nil {
+		t.Fatalf("NormalizeAll failed: %v", err)
+	}
+	want := []string{"go", "grpc", "ｇｏ"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	if _, err := NormalizeAll([]string{"go", "  "}); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Expected ErrEmpty, got %v", err)
+	}
+	if _, err := Normalize(strings.Repeat("あ", MaxLength+1)); err == nil {
+		t.Error("Expected an error for a too long tag")
+	}
+	if _, err := Normalize(strings.Repeat("あ", MaxLength)); err != nil {
+		t.Errorf("Expected a tag of %d characters to be valid, got %v", MaxLength, err)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	long := strings.Repeat("あ", MaxLength)
+	got := Truncate([]string{" Go ", "  ", "go", long + "い", long, strings.Repeat("a", MaxLength-1) + " b"})
+	want := []string{"go", long, strings.Repeat("a", MaxLength-1)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestReplace(t *testing.T) {
+	tests := []struct {
+		name        string
+		tags, from  []string
+		to          string
+		want        []string
+		wantChanged bool
+	}{
+		{"rename", []string{"a", "golang", "b"}, []string{"golang"}, "go", []string{"a", "go", "b"}, true},
+		{"merge into existing", []string{"go", "a", "golang"}, []string{"golang"}, "go", []string{"go", "a"}, true},
+		{"merge several", []string{"golang", "a", "go-lang"}, []string{"golang", "go-lang"}, "go", []string{"go", "a"}, true},
+		{"delete", []string{"a", "b"}, []string{"a"}, "", []string{"b"}, true},
+		{"unaffected", []string{"a", "b"}, []string{"c"}, "d", []string{"a", "b"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed := Replace(tt.tags, tt.from, tt.to)
+			if !reflect.DeepEqual(got, tt.want) || changed != tt.wantChanged {
+				t.Errorf("Expected %v (changed %v), got %v (changed %v)", tt.want, tt.wantChanged, got, changed)
+			}
+		})
+	}
+}